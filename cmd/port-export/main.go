@@ -0,0 +1,67 @@
+// Command port-export generates Terraform configuration for the resources of an existing Port organization, with
+// `import` blocks that adopt them into the Terraform state.
+//
+// It reads the organization with the PORT_CLIENT_ID, PORT_CLIENT_SECRET and PORT_BASE_URL environment variables, like
+// the provider does, and writes a .tf file per resource type to the output directory:
+//
+//	port-export -out ./port
+//	cd port && terraform plan
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/consts"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/export"
+	"github.com/port-labs/terraform-provider-port-labs/v2/provider"
+)
+
+func main() {
+	var out string
+	flag.StringVar(&out, "out", "port-export", "the directory to write the generated configuration to")
+	flag.Parse()
+
+	config := export.Config{
+		BaseURL:      os.Getenv("PORT_BASE_URL"),
+		ClientID:     os.Getenv("PORT_CLIENT_ID"),
+		ClientSecret: os.Getenv("PORT_CLIENT_SECRET"),
+	}
+	if config.ClientID == "" || config.ClientSecret == "" {
+		log.Fatal("PORT_CLIENT_ID and PORT_CLIENT_SECRET must be set")
+	}
+	if config.BaseURL == "" {
+		config.BaseURL = consts.DefaultBaseUrl
+	}
+
+	ctx := context.Background()
+	client, err := cli.New(config.BaseURL)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if _, err = client.Authenticate(ctx, config.ClientID, config.ClientSecret); err != nil {
+		log.Fatalf("failed to authenticate with Port: %s", err)
+	}
+
+	exporter, err := export.New(ctx, client, providerserver.NewProtocol6(provider.New())(), config)
+	if err != nil {
+		log.Fatal(err)
+	}
+	result, err := exporter.Export(ctx, out)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	for _, skipped := range result.Skipped {
+		fmt.Fprintf(os.Stderr, "skipped %s\n", skipped)
+	}
+	fmt.Printf("exported %d resources to %s\n", result.Exported, out)
+	if len(result.Skipped) > 0 {
+		os.Exit(1)
+	}
+}
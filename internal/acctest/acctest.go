@@ -3,46 +3,105 @@ package acctest
 import (
 	"fmt"
 	"os"
+	"sync"
+	"sync/atomic"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cassette"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
 	"github.com/port-labs/terraform-provider-port-labs/v2/internal/consts"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/fakeport"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/utils"
 	"github.com/port-labs/terraform-provider-port-labs/v2/provider"
 )
 
-var (
-	// testAccProtoV6ProviderFactories are used to instantiate a provider during
-	// acceptance testing. The factory function will be invoked for every Terraform
-	// CLI command executed to create a provider server to which the CLI can
-	// reattach.
-	TestAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
-		consts.ProviderName: providerserver.NewProtocol6WithError(provider.New()),
+// ProviderFactories are used to instantiate a provider during acceptance testing. The factory function will be
+// invoked for every Terraform CLI command executed to create a provider server to which the CLI can reattach. The
+// provider records or replays the requests of the test with its own cassette, when a cassette directory is set.
+func ProviderFactories(t *testing.T) map[string]func() (tfprotov6.ProviderServer, error) {
+	t.Helper()
+	startCassette(t)
+	return map[string]func() (tfprotov6.ProviderServer, error){
+		consts.ProviderName: providerserver.NewProtocol6WithError(provider.NewWithClientOptions(cli.WithCassette(t.Name()))),
 	}
+}
+
+// testCassette is the cassette of a test, and the count of the identifiers the test generated with it.
+type testCassette struct {
+	runID string
+	ids   atomic.Int64
+}
+
+var (
+	testCassettesMutex sync.Mutex
+	testCassettes      = map[string]*testCassette{}
 )
 
-var ProviderConfig = fmt.Sprintf(`provider "port" {
-	client_id = "%s"
-	secret = "%s"
-	base_url = "%s"
+// startCassette starts the cassette of the test once, when a cassette directory is set.
+func startCassette(t *testing.T) *testCassette {
+	t.Helper()
+	testCassettesMutex.Lock()
+	defer testCassettesMutex.Unlock()
+	if c, ok := testCassettes[t.Name()]; ok {
+		return c
 	}
-`, os.Getenv("PORT_CLIENT_ID"), os.Getenv("PORT_CLIENT_SECRET"), os.Getenv("PORT_BASE_URL"))
+	runID, err := cassette.Start(t.Name())
+	if err != nil {
+		t.Fatalf("failed to start the cassette of the test: %s", err)
+	}
+	c := &testCassette{runID: runID}
+	testCassettes[t.Name()] = c
+	return c
+}
 
-var ProviderConfigNoPropertyTypeProtection = fmt.Sprintf(`provider "port" {
-	client_id = "%s"
-	secret = "%s"
-	base_url = "%s"
-	blueprint_property_type_change_protection = false
+// GenID generates a random identifier for the resources of a test. While recording or replaying a cassette, the
+// identifiers are the run ID of the recording followed by a counter of the test instead, so a replay sends the same
+// requests as the recording did, whichever tests run and in whichever order.
+func GenID(t *testing.T) string {
+	t.Helper()
+	if !cassette.Enabled() {
+		return utils.GenID()
 	}
-`, os.Getenv("PORT_CLIENT_ID"), os.Getenv("PORT_CLIENT_SECRET"), os.Getenv("PORT_BASE_URL"))
+	c := startCassette(t)
+	return fmt.Sprintf("t-%s-%06d", c.runID, c.ids.Add(1))
+}
+
+// fakeServer is the fake Port API the acceptance tests run against when PORT_FAKE_API is set, instead of a live Port
+// organization. It's shared by all the tests of the package and lives as long as the test process.
+var fakeServer = startFakeServer()
 
-var ProviderConfigNoEscapeHTML = fmt.Sprintf(`provider "port" {
+func startFakeServer() *fakeport.Server {
+	if os.Getenv("PORT_FAKE_API") == "" {
+		return nil
+	}
+	server := fakeport.NewServer()
+	// Tests that pass the credentials to Terraform themselves read them from the environment.
+	_ = os.Setenv("PORT_CLIENT_ID", server.ClientID)
+	_ = os.Setenv("PORT_CLIENT_SECRET", server.ClientSecret)
+	_ = os.Setenv("PORT_BASE_URL", server.URL)
+	return server
+}
+
+func providerConfig(attributes string) string {
+	clientID, secret, baseURL := os.Getenv("PORT_CLIENT_ID"), os.Getenv("PORT_CLIENT_SECRET"), os.Getenv("PORT_BASE_URL")
+	if fakeServer != nil {
+		clientID, secret, baseURL = fakeServer.ClientID, fakeServer.ClientSecret, fakeServer.URL
+	}
+	return fmt.Sprintf(`provider "port" {
 	client_id = "%s"
 	secret = "%s"
 	base_url = "%s"
-	json_escape_html = false
-	}
-`, os.Getenv("PORT_CLIENT_ID"), os.Getenv("PORT_CLIENT_SECRET"), os.Getenv("PORT_BASE_URL"))
+%s	}
+`, clientID, secret, baseURL, attributes)
+}
+
+var ProviderConfig = providerConfig("")
+
+var ProviderConfigNoPropertyTypeProtection = providerConfig("\tblueprint_property_type_change_protection = false\n")
+
+var ProviderConfigNoEscapeHTML = providerConfig("\tjson_escape_html = false\n")
 
 func TestAccPreCheck(t *testing.T) {
 	if v := os.Getenv("PORT_CLIENT_ID"); v == "" {
@@ -53,3 +112,9 @@ func TestAccPreCheck(t *testing.T) {
 		t.Fatal("PORT_CLIENT_SECRET must be set for acceptance tests")
 	}
 }
+
+// FakeServer returns the fake Port API the acceptance tests run against, or nil when they run against a live Port
+// organization. Tests that call the client directly start their own fake with fakeclient.New instead.
+func FakeServer() *fakeport.Server {
+	return fakeServer
+}
@@ -0,0 +1,29 @@
+// Package fakeclient starts a fake Port API with a client authenticated against it, for the tests that call the
+// internal/cli package directly. It's apart from the acctest package, which imports the provider, so the tests inside
+// the packages of the resources can use it too.
+package fakeclient
+
+import (
+	"context"
+	"testing"
+
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/fakeport"
+)
+
+// New starts a fake Port API, which is closed when the test finishes, and returns it with a client authenticated
+// against it.
+func New(t testing.TB) (*fakeport.Server, *cli.PortClient) {
+	t.Helper()
+	server := fakeport.NewServer()
+	t.Cleanup(server.Close)
+
+	client, err := cli.New(server.URL)
+	if err != nil {
+		t.Fatalf("failed to create the client of the fake Port API: %s", err)
+	}
+	if _, err := client.Authenticate(context.Background(), server.ClientID, server.ClientSecret); err != nil {
+		t.Fatalf("failed to authenticate with the fake Port API: %s", err)
+	}
+	return server, client
+}
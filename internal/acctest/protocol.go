@@ -0,0 +1,231 @@
+package acctest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/fakeport"
+	"github.com/port-labs/terraform-provider-port-labs/v2/provider"
+)
+
+// ListResult is a result of a list block, decoded with the schemas of the listed resource.
+type ListResult struct {
+	DisplayName string
+	Identity    map[string]tftypes.Value
+	Resource    map[string]tftypes.Value
+}
+
+// configuredProvider returns a provider server configured for the fake Port API, with its schemas. The plugin testing
+// framework can't run query steps nor invoke actions yet, so list resources and actions are tested through the
+// protocol instead, like the data sources that need objects only the API creates.
+func configuredProvider(t *testing.T, server *fakeport.Server) (tfprotov6.ProviderServer, *tfprotov6.GetProviderSchemaResponse) {
+	t.Helper()
+	ctx := context.Background()
+	providerServer := providerserver.NewProtocol6(provider.New())()
+
+	schemaResp, err := providerServer.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	requireNoDiagnostics(t, err, schemaResp.Diagnostics)
+
+	configureResp, err := providerServer.ConfigureProvider(ctx, &tfprotov6.ConfigureProviderRequest{
+		Config: dynamicValue(t, schemaResp.Provider.ValueType(), map[string]tftypes.Value{
+			"client_id": tftypes.NewValue(tftypes.String, server.ClientID),
+			"secret":    tftypes.NewValue(tftypes.String, server.ClientSecret),
+			"base_url":  tftypes.NewValue(tftypes.String, server.URL),
+		}),
+	})
+	requireNoDiagnostics(t, err, configureResp.Diagnostics)
+	return providerServer, schemaResp
+}
+
+// List runs a list block against a provider configured for the fake Port API, the way `terraform query` does.
+func List(t *testing.T, server *fakeport.Server, typeName string, config map[string]tftypes.Value, includeResource bool) []ListResult {
+	t.Helper()
+	ctx := context.Background()
+	providerServer, schemaResp := configuredProvider(t, server)
+
+	identityResp, err := providerServer.GetResourceIdentitySchemas(ctx, &tfprotov6.GetResourceIdentitySchemasRequest{})
+	requireNoDiagnostics(t, err, identityResp.Diagnostics)
+
+	stream, err := providerServer.(tfprotov6.ProviderServerWithListResource).ListResource(ctx, &tfprotov6.ListResourceRequest{
+		TypeName:        typeName,
+		Config:          dynamicValue(t, schemaResp.ListResourceSchemas[typeName].ValueType(), config),
+		IncludeResource: includeResource,
+	})
+	requireNoDiagnostics(t, err, nil)
+
+	identityType := identityResp.IdentitySchemas[typeName].ValueType()
+	resourceType := schemaResp.ResourceSchemas[typeName].ValueType()
+	var results []ListResult
+	for result := range stream.Results {
+		requireNoDiagnostics(t, nil, result.Diagnostics)
+		listResult := ListResult{DisplayName: result.DisplayName}
+		listResult.Identity = objectAttributes(t, result.Identity.IdentityData, identityType)
+		if result.Resource != nil {
+			listResult.Resource = objectAttributes(t, result.Resource, resourceType)
+		}
+		results = append(results, listResult)
+	}
+	return results
+}
+
+// ImportFromIdentity imports a resource by its identity, like the identity of a list result, and reads it the way
+// Terraform does for an import block that sets `identity`. It returns the state the resource was imported with.
+func ImportFromIdentity(t *testing.T, server *fakeport.Server, typeName string, identity map[string]tftypes.Value) map[string]tftypes.Value {
+	t.Helper()
+	ctx := context.Background()
+	providerServer, schemaResp := configuredProvider(t, server)
+
+	identityResp, err := providerServer.GetResourceIdentitySchemas(ctx, &tfprotov6.GetResourceIdentitySchemasRequest{})
+	requireNoDiagnostics(t, err, identityResp.Diagnostics)
+
+	importResp, err := providerServer.ImportResourceState(ctx, &tfprotov6.ImportResourceStateRequest{
+		TypeName: typeName,
+		Identity: &tfprotov6.ResourceIdentityData{
+			IdentityData: dynamicValue(t, identityResp.IdentitySchemas[typeName].ValueType(), identity),
+		},
+	})
+	requireNoDiagnostics(t, err, importResp.Diagnostics)
+	if len(importResp.ImportedResources) != 1 {
+		t.Fatalf("expected 1 imported resource, got %d", len(importResp.ImportedResources))
+	}
+	imported := importResp.ImportedResources[0]
+
+	readResp, err := providerServer.ReadResource(ctx, &tfprotov6.ReadResourceRequest{
+		TypeName:        typeName,
+		CurrentState:    imported.State,
+		CurrentIdentity: imported.Identity,
+		Private:         imported.Private,
+	})
+	requireNoDiagnostics(t, err, readResp.Diagnostics)
+	return objectAttributes(t, readResp.NewState, schemaResp.ResourceSchemas[typeName].ValueType())
+}
+
+// Invoke invokes an action against a provider configured for the fake Port API, the way Terraform does when one of
+// its triggers fires. It returns the progress messages of the action and the diagnostics it completed with.
+func Invoke(t *testing.T, server *fakeport.Server, actionType string, config map[string]tftypes.Value) ([]string, []*tfprotov6.Diagnostic) {
+	t.Helper()
+	ctx := context.Background()
+	providerServer, schemaResp := configuredProvider(t, server)
+
+	stream, err := providerServer.(tfprotov6.ProviderServerWithActions).InvokeAction(ctx, &tfprotov6.InvokeActionRequest{
+		ActionType: actionType,
+		Config:     dynamicValue(t, schemaResp.ActionSchemas[actionType].Schema.ValueType(), config),
+	})
+	requireNoDiagnostics(t, err, nil)
+
+	var progress []string
+	var diagnostics []*tfprotov6.Diagnostic
+	for event := range stream.Events {
+		switch eventType := event.Type.(type) {
+		case tfprotov6.ProgressInvokeActionEventType:
+			progress = append(progress, eventType.Message)
+		case tfprotov6.CompletedInvokeActionEventType:
+			diagnostics = append(diagnostics, eventType.Diagnostics...)
+		}
+	}
+	return progress, diagnostics
+}
+
+// ReadDataSource reads a data source against a provider configured for the fake Port API, the way Terraform does
+// during a plan. It returns the state of the data source and the diagnostics it was read with.
+func ReadDataSource(t *testing.T, server *fakeport.Server, typeName string, config map[string]tftypes.Value) (map[string]tftypes.Value, []*tfprotov6.Diagnostic) {
+	t.Helper()
+	ctx := context.Background()
+	providerServer, schemaResp := configuredProvider(t, server)
+
+	dataSourceType := schemaResp.DataSourceSchemas[typeName].ValueType()
+	readResp, err := providerServer.ReadDataSource(ctx, &tfprotov6.ReadDataSourceRequest{
+		TypeName: typeName,
+		Config:   dynamicValue(t, dataSourceType, config),
+	})
+	requireNoDiagnostics(t, err, nil)
+	if readResp.State == nil {
+		return nil, readResp.Diagnostics
+	}
+	return objectAttributes(t, readResp.State, dataSourceType), readResp.Diagnostics
+}
+
+// CallFunction calls a provider function the way Terraform does, with arguments of the types of its parameters. It
+// returns the result of the function, decoded with its return type, and the error it failed with.
+func CallFunction(t *testing.T, name string, arguments ...tftypes.Value) (tftypes.Value, *tfprotov6.FunctionError) {
+	t.Helper()
+	ctx := context.Background()
+	providerServer := providerserver.NewProtocol6(provider.New())()
+
+	functionsResp, err := providerServer.GetFunctions(ctx, &tfprotov6.GetFunctionsRequest{})
+	requireNoDiagnostics(t, err, functionsResp.Diagnostics)
+	function, ok := functionsResp.Functions[name]
+	if !ok {
+		t.Fatalf("the provider has no function %s", name)
+	}
+
+	var dynamicArguments []*tfprotov6.DynamicValue
+	for i, argument := range arguments {
+		argumentType := function.Parameters[i].Type
+		value, err := tfprotov6.NewDynamicValue(argumentType, argument)
+		if err != nil {
+			t.Fatalf("failed to build argument %d: %s", i, err)
+		}
+		dynamicArguments = append(dynamicArguments, &value)
+	}
+
+	callResp, err := providerServer.CallFunction(ctx, &tfprotov6.CallFunctionRequest{
+		Name:      name,
+		Arguments: dynamicArguments,
+	})
+	requireNoDiagnostics(t, err, nil)
+	if callResp.Error != nil {
+		return tftypes.Value{}, callResp.Error
+	}
+	result, err := callResp.Result.Unmarshal(function.Return.Type)
+	if err != nil {
+		t.Fatalf("failed to read the result: %s", err)
+	}
+	return result, nil
+}
+
+// dynamicValue builds an object of the given type with the given attributes, leaving the rest null.
+func dynamicValue(t *testing.T, valueType tftypes.Type, attributes map[string]tftypes.Value) *tfprotov6.DynamicValue {
+	t.Helper()
+	objectType := valueType.(tftypes.Object)
+	values := map[string]tftypes.Value{}
+	for name, attributeType := range objectType.AttributeTypes {
+		values[name] = tftypes.NewValue(attributeType, nil)
+		if value, ok := attributes[name]; ok {
+			values[name] = value
+		}
+	}
+	value, err := tfprotov6.NewDynamicValue(objectType, tftypes.NewValue(objectType, values))
+	if err != nil {
+		t.Fatalf("failed to build the value: %s", err)
+	}
+	return &value
+}
+
+func objectAttributes(t *testing.T, value *tfprotov6.DynamicValue, valueType tftypes.Type) map[string]tftypes.Value {
+	t.Helper()
+	object, err := value.Unmarshal(valueType)
+	if err != nil {
+		t.Fatalf("failed to read the value: %s", err)
+	}
+	var attributes map[string]tftypes.Value
+	if err = object.As(&attributes); err != nil {
+		t.Fatalf("failed to read the value: %s", err)
+	}
+	return attributes
+}
+
+func requireNoDiagnostics(t *testing.T, err error, diagnostics []*tfprotov6.Diagnostic) {
+	t.Helper()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, diagnostic := range diagnostics {
+		if diagnostic.Severity == tfprotov6.DiagnosticSeverityError {
+			t.Fatalf("%s: %s", diagnostic.Summary, diagnostic.Detail)
+		}
+	}
+}
@@ -0,0 +1,258 @@
+// Package cassette records the HTTP interactions of the Port client to disk and replays them, so acceptance tests can
+// run offline and bug reports can come with a reproducible trace of the API calls.
+//
+// A cassette is a JSON lines file with one interaction per line. Credentials, access tokens and secret values are
+// redacted before they're written. The secrets a response echoes back from an earlier request are replaced with a
+// reference to that request, and the player puts back the value the replayed request sends. The cassettes of tests start with a header line
+// that holds the run ID of the recording, which the identifiers the test generates are prefixed with.
+package cassette
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+const (
+	// RecordEnvVar is the environment variable that sets the directory to record the cassettes to.
+	RecordEnvVar = "PORT_HTTP_RECORD"
+	// ReplayEnvVar is the environment variable that sets the directory to replay the cassettes from.
+	ReplayEnvVar = "PORT_HTTP_REPLAY"
+
+	// Redacted replaces the sensitive values in the recorded interactions.
+	Redacted = "REDACTED"
+)
+
+// redactedHeaders are the headers whose values are never written to a cassette.
+var redactedHeaders = []string{"Authorization", "Cookie", "Set-Cookie", "Proxy-Authorization"}
+
+// credentialFields are the JSON fields whose values are never written to a cassette, in any request or response body.
+var credentialFields = map[string]bool{
+	"clientsecret": true,
+	"accesstoken":  true,
+	"token":        true,
+	"password":     true,
+}
+
+// secretFields are the JSON fields of the secret values the provider is configured with, like the security secret of
+// a webhook. They're redacted too, but the responses refer to the request that sent them, so a replayed resource
+// refreshes the secret of its configuration.
+var secretFields = map[string]bool{
+	"secret":      true,
+	"secretvalue": true,
+}
+
+// Header is the first line of the cassette of a test.
+type Header struct {
+	RunID string `json:"runId"`
+}
+
+// Interaction is a recorded request and the response it got.
+type Interaction struct {
+	Request  Request  `json:"request"`
+	Response Response `json:"response"`
+}
+
+type Request struct {
+	Method string `json:"method"`
+	// URL is the path and query of the request. The host is left out, so a cassette can be replayed against any
+	// base URL.
+	URL     string      `json:"url"`
+	Headers http.Header `json:"headers,omitempty"`
+	Body    string      `json:"body,omitempty"`
+}
+
+type Response struct {
+	StatusCode int         `json:"statusCode"`
+	Headers    http.Header `json:"headers,omitempty"`
+	Body       string      `json:"body,omitempty"`
+}
+
+// Enabled reports whether the environment asks to record or replay cassettes.
+func Enabled() bool {
+	return os.Getenv(RecordEnvVar) != "" || os.Getenv(ReplayEnvVar) != ""
+}
+
+// FromEnv wraps next with a recorder or a player of the cassette called name, according to the RecordEnvVar and
+// ReplayEnvVar environment variables. It returns next as is when neither is set.
+func FromEnv(next http.RoundTripper, name string) (http.RoundTripper, error) {
+	recordDir, replayDir := os.Getenv(RecordEnvVar), os.Getenv(ReplayEnvVar)
+	switch {
+	case recordDir != "" && replayDir != "":
+		return nil, fmt.Errorf("%s and %s can't be set together", RecordEnvVar, ReplayEnvVar)
+	case recordDir != "":
+		if err := os.MkdirAll(recordDir, 0o700); err != nil {
+			return nil, fmt.Errorf("failed to create the cassette directory: %w", err)
+		}
+		return NewRecorder(Path(recordDir, name), next), nil
+	case replayDir != "":
+		return NewPlayer(Path(replayDir, name))
+	}
+	return next, nil
+}
+
+// Start starts the cassette of a test called name and returns the run ID of its recording. While recording, the
+// cassette is recorded from scratch with a random run ID, so the objects of the test don't collide with the ones
+// earlier recordings left in the organization. While replaying, the run ID is read back from the cassette. It returns
+// an empty run ID when neither is set.
+func Start(name string) (string, error) {
+	recordDir, replayDir := os.Getenv(RecordEnvVar), os.Getenv(ReplayEnvVar)
+	switch {
+	case recordDir != "" && replayDir != "":
+		return "", fmt.Errorf("%s and %s can't be set together", RecordEnvVar, ReplayEnvVar)
+	case recordDir != "":
+		if err := os.MkdirAll(recordDir, 0o700); err != nil {
+			return "", fmt.Errorf("failed to create the cassette directory: %w", err)
+		}
+		runID := make([]byte, 4)
+		if _, err := rand.Read(runID); err != nil {
+			return "", err
+		}
+		header := Header{RunID: hex.EncodeToString(runID)}
+		line, err := json.Marshal(header)
+		if err != nil {
+			return "", err
+		}
+		path := Path(recordDir, name)
+		writeMutex.Lock()
+		delete(recordedSecrets, path)
+		writeMutex.Unlock()
+		if err = os.WriteFile(path, append(line, '\n'), 0o600); err != nil {
+			return "", fmt.Errorf("failed to create the cassette: %w", err)
+		}
+		return header.RunID, nil
+	case replayDir != "":
+		player, err := NewPlayer(Path(replayDir, name))
+		if err != nil {
+			return "", err
+		}
+		if player.header.RunID == "" {
+			return "", fmt.Errorf("the cassette %s has no run ID, record it again", player.path)
+		}
+		return player.header.RunID, nil
+	}
+	return "", nil
+}
+
+// Path returns the cassette called name in dir. Without a name, the cassette is named after the executable, so every
+// provider binary records to its own cassette.
+func Path(dir string, name string) string {
+	if name == "" {
+		name = strings.TrimSuffix(filepath.Base(os.Args[0]), ".exe")
+	}
+	return filepath.Join(dir, unsafePathChars.ReplaceAllString(name, "_")+".jsonl")
+}
+
+// unsafePathChars are the characters of test names, like the slash of subtests, that aren't kept in cassette names.
+var unsafePathChars = regexp.MustCompile(`[^A-Za-z0-9._-]+`)
+
+func newRequest(req *http.Request, body []byte) Request {
+	return Request{
+		Method:  req.Method,
+		URL:     req.URL.RequestURI(),
+		Headers: redactHeaders(req.Header),
+		Body: redactBody(body, func(field, _, _ string) (string, bool) {
+			return Redacted, credentialFields[field] || secretFields[field]
+		}),
+	}
+}
+
+// secretRef is the placeholder of the secret at path of the body of the request with key. It's derived from the
+// redacted request only, so the recorder and the player compute the same placeholder.
+func secretRef(key string, path string) string {
+	sum := sha256.Sum256([]byte(key + "\n" + path))
+	return Redacted + ":" + hex.EncodeToString(sum[:6])
+}
+
+// sentSecrets returns the secret values of a request body by their placeholder.
+func sentSecrets(key string, body []byte) map[string]string {
+	secrets := map[string]string{}
+	redactBody(body, func(field, path, value string) (string, bool) {
+		if secretFields[field] {
+			secrets[secretRef(key, path)] = value
+		}
+		return "", false
+	})
+	return secrets
+}
+
+// key identifies the requests a recorded interaction can be replayed for. Requests are matched by their method, URL
+// and redacted body, as the replayed client sends the redacted tokens it was given back.
+func (r Request) key() string {
+	return r.Method + " " + r.URL + "\n" + r.Body
+}
+
+func (r Response) httpResponse(req *http.Request) *http.Response {
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", r.StatusCode, http.StatusText(r.StatusCode)),
+		StatusCode:    r.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        r.Headers.Clone(),
+		Body:          io.NopCloser(strings.NewReader(r.Body)),
+		ContentLength: int64(len(r.Body)),
+		Request:       req,
+	}
+}
+
+func redactHeaders(headers http.Header) http.Header {
+	redacted := headers.Clone()
+	for _, name := range redactedHeaders {
+		if redacted.Get(name) != "" {
+			redacted.Set(name, Redacted)
+		}
+	}
+	if len(redacted) == 0 {
+		return nil
+	}
+	return redacted
+}
+
+// redactBody replaces the string fields of a JSON body that redact returns a replacement for, given the lower case
+// name, path and value of every field, and re-encodes it, so equal bodies are recorded the same way regardless of
+// their field order. Bodies that aren't JSON are kept as is.
+func redactBody(body []byte, redact func(field, path, value string) (string, bool)) string {
+	if len(bytes.TrimSpace(body)) == 0 {
+		return ""
+	}
+	var value any
+	if err := json.Unmarshal(body, &value); err != nil {
+		return string(body)
+	}
+	redacted, err := json.Marshal(redactValue(value, "", redact))
+	if err != nil {
+		return string(body)
+	}
+	return string(redacted)
+}
+
+func redactValue(value any, path string, redact func(field, path, value string) (string, bool)) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for field, fieldValue := range v {
+			fieldPath := path + "." + field
+			if s, isString := fieldValue.(string); isString {
+				if replacement, ok := redact(strings.ToLower(field), fieldPath, s); ok {
+					v[field] = replacement
+				}
+				continue
+			}
+			v[field] = redactValue(fieldValue, fieldPath, redact)
+		}
+	case []any:
+		for i := range v {
+			v[i] = redactValue(v[i], fmt.Sprintf("%s[%d]", path, i), redact)
+		}
+	}
+	return value
+}
@@ -0,0 +1,158 @@
+package cassette
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecordAndReplay(t *testing.T) {
+	count := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/v1/auth/access_token" {
+			_, _ = w.Write([]byte(`{"ok":true,"accessToken":"live-token","expiresIn":3600}`))
+			return
+		}
+		count++
+		_, _ = w.Write([]byte(`{"ok":true,"count":` + strings.Repeat("1", count) + `}`))
+	}))
+	path := filepath.Join(t.TempDir(), "cassette.jsonl")
+
+	recording := &http.Client{Transport: NewRecorder(path, http.DefaultTransport)}
+	resp := do(t, recording, http.MethodPost, server.URL+"/v1/auth/access_token", `{"clientId":"id","clientSecret":"live-secret"}`, "")
+	assert.Contains(t, resp, "live-token", "the client gets the real response while recording")
+	assert.Equal(t, `{"ok":true,"count":1}`, do(t, recording, http.MethodGet, server.URL+"/v1/blueprints/service", "", "Bearer live-token"))
+	assert.Equal(t, `{"ok":true,"count":11}`, do(t, recording, http.MethodGet, server.URL+"/v1/blueprints/service", "", "Bearer live-token"))
+	server.Close()
+
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.NotContains(t, string(content), "live-secret")
+	assert.NotContains(t, string(content), "live-token")
+	assert.Equal(t, 3, strings.Count(string(content), "\n"))
+
+	player, err := NewPlayer(path)
+	require.NoError(t, err)
+	replaying := &http.Client{Transport: player}
+	const baseURL = "http://replayed.invalid"
+	assert.JSONEq(t, `{"ok":true,"accessToken":"REDACTED","expiresIn":3600}`,
+		do(t, replaying, http.MethodPost, baseURL+"/v1/auth/access_token", `{"clientSecret":"other-secret","clientId":"id"}`, ""))
+	assert.Equal(t, `{"count":1,"ok":true}`, do(t, replaying, http.MethodGet, baseURL+"/v1/blueprints/service", "", "Bearer REDACTED"))
+	assert.Equal(t, `{"count":11,"ok":true}`, do(t, replaying, http.MethodGet, baseURL+"/v1/blueprints/service", "", "Bearer REDACTED"))
+	assert.Equal(t, `{"count":11,"ok":true}`, do(t, replaying, http.MethodGet, baseURL+"/v1/blueprints/service", "", "Bearer REDACTED"),
+		"the last response is replayed again once all of them were")
+
+	resp = do(t, replaying, http.MethodGet, baseURL+"/v1/blueprints/missing", "", "")
+	assert.Contains(t, resp, "not_recorded")
+}
+
+func do(t *testing.T, client *http.Client, method, url, body, authorization string) string {
+	req, err := http.NewRequest(method, url, strings.NewReader(body))
+	require.NoError(t, err)
+	if authorization != "" {
+		req.Header.Set("Authorization", authorization)
+	}
+	resp, err := client.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	content, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	return string(content)
+}
+
+func TestFromEnv(t *testing.T) {
+	t.Setenv(RecordEnvVar, "")
+	t.Setenv(ReplayEnvVar, "")
+	transport, err := FromEnv(http.DefaultTransport, "")
+	require.NoError(t, err)
+	assert.Equal(t, http.DefaultTransport, transport)
+
+	dir := filepath.Join(t.TempDir(), "cassettes")
+	t.Setenv(RecordEnvVar, dir)
+	transport, err = FromEnv(http.DefaultTransport, "")
+	require.NoError(t, err)
+	assert.IsType(t, &Recorder{}, transport)
+	assert.DirExists(t, dir)
+	assert.Equal(t, filepath.Join(dir, "TestFromEnv_subtest_1.jsonl"), Path(dir, "TestFromEnv/subtest #1"))
+
+	t.Setenv(ReplayEnvVar, dir)
+	_, err = FromEnv(http.DefaultTransport, "")
+	assert.Error(t, err, "recording and replaying are exclusive")
+}
+
+func TestSecretsInResponses(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/v1/organization/secrets" {
+			_, _ = w.Write([]byte(`{"ok":true,"secret":{"secretName":"generated","secretValue":"generated-secret"}}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"ok":true,"accessToken":"live-token","integration":{"security":{"secret":"webhook-secret"}}}`))
+	}))
+	defer server.Close()
+	path := filepath.Join(t.TempDir(), "cassette.jsonl")
+
+	recording := &http.Client{Transport: NewRecorder(path, http.DefaultTransport)}
+	do(t, recording, http.MethodPost, server.URL+"/v1/webhooks", `{"security":{"secret":"webhook-secret"}}`, "")
+	do(t, recording, http.MethodGet, server.URL+"/v1/webhooks/hook", "", "")
+	do(t, recording, http.MethodPost, server.URL+"/v1/organization/secrets", `{"secretName":"generated"}`, "")
+
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.NotContains(t, string(content), "live-token")
+	assert.NotContains(t, string(content), "webhook-secret")
+	assert.NotContains(t, string(content), "generated-secret")
+	assert.Contains(t, string(content), `"body":"{\"security\":{\"secret\":\"REDACTED\"}}"`, "the secrets sent are redacted")
+	assert.Equal(t, 2, strings.Count(string(content), `\"secret\":\"REDACTED:`), "the responses refer to the request that sent the secret")
+
+	player, err := NewPlayer(path)
+	require.NoError(t, err)
+	replaying := &http.Client{Transport: player}
+	const baseURL = "http://replayed.invalid"
+	assert.JSONEq(t, `{"ok":true,"accessToken":"REDACTED","integration":{"security":{"secret":"replayed-secret"}}}`,
+		do(t, replaying, http.MethodPost, baseURL+"/v1/webhooks", `{"security":{"secret":"replayed-secret"}}`, ""))
+	assert.JSONEq(t, `{"ok":true,"accessToken":"REDACTED","integration":{"security":{"secret":"replayed-secret"}}}`,
+		do(t, replaying, http.MethodGet, baseURL+"/v1/webhooks/hook", "", ""), "a later read refreshes the secret the replay sent")
+	assert.JSONEq(t, `{"ok":true,"secret":{"secretName":"generated","secretValue":"REDACTED"}}`,
+		do(t, replaying, http.MethodPost, baseURL+"/v1/organization/secrets", `{"secretName":"generated"}`, ""),
+		"the secrets no request sent stay redacted")
+}
+
+func TestStart(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv(RecordEnvVar, "")
+	t.Setenv(ReplayEnvVar, "")
+	runID, err := Start("TestStart")
+	require.NoError(t, err)
+	assert.Empty(t, runID)
+
+	t.Setenv(RecordEnvVar, dir)
+	require.NoError(t, os.WriteFile(Path(dir, "TestStart"), []byte("{\"request\":{}}\n"), 0o600))
+	recordedRunID, err := Start("TestStart")
+	require.NoError(t, err)
+	assert.Regexp(t, `^[0-9a-f]{8}$`, recordedRunID)
+	content, err := os.ReadFile(Path(dir, "TestStart"))
+	require.NoError(t, err)
+	assert.Equal(t, `{"runId":"`+recordedRunID+`"}`+"\n", string(content), "the cassette is recorded from scratch")
+
+	otherRunID, err := Start("TestStart/other")
+	require.NoError(t, err)
+	assert.NotEqual(t, recordedRunID, otherRunID, "every recording gets its own run ID")
+
+	t.Setenv(RecordEnvVar, "")
+	t.Setenv(ReplayEnvVar, dir)
+	replayedRunID, err := Start("TestStart")
+	require.NoError(t, err)
+	assert.Equal(t, recordedRunID, replayedRunID)
+
+	_, err = Start("TestMissing")
+	assert.Error(t, err)
+}
@@ -0,0 +1,121 @@
+package cassette
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+)
+
+var (
+	playersMutex sync.Mutex
+	// players are shared by all the clients of the process, so the interactions one of them replayed aren't replayed
+	// again to the next provider instance.
+	players = map[string]*Player{}
+)
+
+// Player answers the requests with the interactions of a cassette, without sending them.
+type Player struct {
+	path   string
+	header Header
+
+	mu           sync.Mutex
+	interactions map[string][]Interaction
+	// replayed counts the replayed interactions of every request key.
+	replayed map[string]int
+	// secrets are the secret values the replayed requests sent, by their placeholder.
+	secrets map[string]string
+}
+
+// NewPlayer loads the cassette at path. The players of the same cassette are shared within the process.
+func NewPlayer(path string) (*Player, error) {
+	playersMutex.Lock()
+	defer playersMutex.Unlock()
+	if p, ok := players[path]; ok {
+		return p, nil
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open the cassette: %w", err)
+	}
+	defer f.Close()
+
+	p := &Player{path: path, interactions: map[string][]Interaction{}, replayed: map[string]int{}, secrets: map[string]string{}}
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(nil, 64*1024*1024)
+	for line := 1; scanner.Scan(); line++ {
+		if len(bytes.TrimSpace(scanner.Bytes())) == 0 {
+			continue
+		}
+		if line == 1 {
+			if err = json.Unmarshal(scanner.Bytes(), &p.header); err != nil {
+				return nil, fmt.Errorf("failed to parse line %d of the cassette %s: %w", line, path, err)
+			}
+			if p.header.RunID != "" {
+				continue
+			}
+		}
+		var interaction Interaction
+		if err = json.Unmarshal(scanner.Bytes(), &interaction); err != nil {
+			return nil, fmt.Errorf("failed to parse line %d of the cassette %s: %w", line, path, err)
+		}
+		key := interaction.Request.key()
+		p.interactions[key] = append(p.interactions[key], interaction)
+	}
+	if err = scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read the cassette %s: %w", path, err)
+	}
+	players[path] = p
+	return p, nil
+}
+
+// RoundTrip replays the responses recorded for the request in the order they were recorded. Once they're all
+// replayed, the last one is replayed again, so polling more times than the recording did keeps working. The secrets
+// the response refers to are replaced with the values the replayed requests sent. A request that was never recorded
+// gets a 501 response that names it.
+func (p *Player) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		_ = req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+	key := newRequest(req, body).key()
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	maps.Copy(p.secrets, sentSecrets(key, body))
+	recorded := p.interactions[key]
+	if len(recorded) == 0 {
+		notRecorded, _ := json.Marshal(map[string]any{
+			"ok":      false,
+			"error":   "not_recorded",
+			"message": fmt.Sprintf("%s %s wasn't recorded in the cassette %s", req.Method, req.URL.RequestURI(), p.path),
+		})
+		return Response{
+			StatusCode: http.StatusNotImplemented,
+			Headers:    http.Header{"Content-Type": []string{"application/json"}},
+			Body:       string(notRecorded),
+		}.httpResponse(req), nil
+	}
+	i := min(p.replayed[key], len(recorded)-1)
+	p.replayed[key]++
+	response := recorded[i].Response
+	if strings.Contains(response.Body, Redacted+":") {
+		response.Body = redactBody([]byte(response.Body), func(field, _, value string) (string, bool) {
+			secret, ok := p.secrets[value]
+			return secret, ok && secretFields[field]
+		})
+	}
+	return response.httpResponse(req), nil
+}
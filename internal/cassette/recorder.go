@@ -0,0 +1,112 @@
+package cassette
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sync"
+)
+
+var (
+	// writeMutex serializes the writes of all the recorders of the process, which may share a cassette, and guards
+	// recordedSecrets.
+	writeMutex sync.Mutex
+	// recordedSecrets are the placeholders of the secrets every cassette recorded requests with, by their value.
+	recordedSecrets = map[string]map[string]string{}
+)
+
+// Recorder sends the requests with the wrapped transport and appends every interaction to a cassette.
+type Recorder struct {
+	path string
+	next http.RoundTripper
+}
+
+// NewRecorder returns a recorder that appends to the cassette at path. Interactions are appended as they happen, so
+// the clients of all the provider instances of a test record to the same cassette. The cassettes of tests are recorded
+// from scratch by Start, the others are appended to until they're deleted.
+func NewRecorder(path string, next http.RoundTripper) *Recorder {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	return &Recorder{path: path, next: next}
+}
+
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	var requestBody []byte
+	if req.Body != nil {
+		var err error
+		requestBody, err = io.ReadAll(req.Body)
+		_ = req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		req.Body = io.NopCloser(bytes.NewReader(requestBody))
+	}
+
+	resp, err := r.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	responseBody, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(responseBody))
+
+	request := newRequest(req, requestBody)
+	interaction := Interaction{
+		Request: request,
+		Response: Response{
+			StatusCode: resp.StatusCode,
+			Headers:    redactHeaders(resp.Header),
+			Body:       r.redactResponseBody(request.key(), requestBody, responseBody),
+		},
+	}
+	if err = r.append(interaction); err != nil {
+		return nil, fmt.Errorf("failed to record the interaction to %s: %w", r.path, err)
+	}
+	return resp, nil
+}
+
+// redactResponseBody redacts the credentials and secrets of a response. The secrets that a request of the cassette
+// sent are replaced with their placeholder, the others, like the ones Port generates, are redacted for good.
+func (r *Recorder) redactResponseBody(requestKey string, requestBody []byte, responseBody []byte) string {
+	writeMutex.Lock()
+	defer writeMutex.Unlock()
+	secrets := recordedSecrets[r.path]
+	if secrets == nil {
+		secrets = map[string]string{}
+		recordedSecrets[r.path] = secrets
+	}
+	for ref, value := range sentSecrets(requestKey, requestBody) {
+		secrets[value] = ref
+	}
+	return redactBody(responseBody, func(field, _, value string) (string, bool) {
+		if ref, ok := secrets[value]; ok && secretFields[field] {
+			return ref, true
+		}
+		return Redacted, credentialFields[field] || secretFields[field]
+	})
+}
+
+func (r *Recorder) append(interaction Interaction) error {
+	line, err := json.Marshal(interaction)
+	if err != nil {
+		return err
+	}
+	writeMutex.Lock()
+	defer writeMutex.Unlock()
+	f, err := os.OpenFile(r.path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o600)
+	if err != nil {
+		return err
+	}
+	if _, err = f.Write(append(line, '\n')); err != nil {
+		_ = f.Close()
+		return err
+	}
+	return f.Close()
+}
@@ -24,6 +24,23 @@ func (c *PortClient) ReadAction(ctx context.Context, id string) (*Action, int, e
 	return &pb.Action, resp.StatusCode(), nil
 }
 
+func (c *PortClient) ReadActions(ctx context.Context) ([]Action, error) {
+	pb := &PortBody{}
+	url := "v1/actions"
+	resp, err := c.Client.R().
+		SetContext(ctx).
+		SetHeader("Accept", "application/json").
+		SetResult(pb).
+		Get(url)
+	if err != nil {
+		return nil, err
+	}
+	if !pb.OK {
+		return nil, fmt.Errorf("failed to read actions, got: %s", resp.Body())
+	}
+	return pb.Actions, nil
+}
+
 func (c *PortClient) CreateAction(ctx context.Context, action *Action) (*Action, error) {
 	url := "v1/actions"
 	resp, err := c.Client.R().
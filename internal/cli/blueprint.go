@@ -25,6 +25,24 @@ func (c *PortClient) ReadBlueprint(ctx context.Context, id string) (*Blueprint,
 	return &pb.Blueprint, resp.StatusCode(), nil
 }
 
+func (c *PortClient) ReadBlueprints(ctx context.Context) ([]Blueprint, error) {
+	pb := &PortBody{}
+	const url = "v1/blueprints"
+	resp, err := c.Client.R().
+		SetContext(ctx).
+		SetHeader("Accept", "application/json").
+		SetQueryParam("exclude_calculated_properties", "true").
+		SetResult(pb).
+		Get(url)
+	if err != nil {
+		return nil, err
+	}
+	if !pb.OK {
+		return nil, fmt.Errorf("failed to read blueprints, got: %s", resp.Body())
+	}
+	return pb.Blueprints, nil
+}
+
 func (c *PortClient) ReadSystemBlueprintStructure(ctx context.Context, id string) (*Blueprint, int, error) {
 	pb := &PortBody{}
 	const url = "v1/blueprints/system/{identifier}/structure"
@@ -5,12 +5,26 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/go-resty/resty/v2"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cassette"
 	"github.com/port-labs/terraform-provider-port-labs/v2/internal/ratelimit"
 	"github.com/port-labs/terraform-provider-port-labs/v2/internal/utils"
 	"log/slog"
+	"net/http"
 	"os"
 	"slices"
 	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	authURL = "v1/auth/access_token"
+	// tokenRefreshMargin is how long before the access token expires it's refreshed, so requests that are already
+	// in flight don't race the expiry.
+	tokenRefreshMargin = 5 * time.Minute
+	// minTokenRefreshInterval prevents refreshing a token that was just issued when a request keeps failing with 401,
+	// which means the credentials aren't allowed to perform it rather than the token being stale.
+	minTokenRefreshInterval = 30 * time.Second
 )
 
 type Option func(*PortClient)
@@ -22,48 +36,86 @@ type PortClient struct {
 	featureFlags                          []string
 	JSONEscapeHTML                        bool
 	BlueprintPropertyTypeChangeProtection bool
-}
 
-func isTooManyRequests(r *resty.Response, _ error) bool {
-	return r.StatusCode() == 429
+	rateLimitManager *ratelimit.Manager
+	// baseTransport is the transport the rate limit manager wraps to limit the amount of concurrent requests. It
+	// records or replays the requests when a cassette is set in the environment.
+	baseTransport http.RoundTripper
+	// cassetteName is the cassette the requests are recorded to or replayed from, the one of the executable by default.
+	cassetteName string
+
+	// tokenMutex guards the credentials and the access token, and serializes their refreshes.
+	tokenMutex     sync.Mutex
+	clientSecret   string
+	accessToken    string
+	tokenIssuedAt  time.Time
+	tokenExpiresAt time.Time
 }
 
 func New(baseURL string, opts ...Option) (*PortClient, error) {
-	ratelimitOpts := &ratelimit.Options{
-		Enabled: utils.PtrTo(os.Getenv("PORT_RATE_LIMIT_DISABLED") == ""),
-	}
-	if isDebug := os.Getenv("PORT_DEBUG_RATE_LIMIT") != ""; isDebug {
-		ratelimitOpts.Logger = slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelDebug}))
-	}
-	rateLimitManager := ratelimit.New(ratelimitOpts)
-
-	c := &PortClient{
-		Client: resty.New().
-			SetRateLimiter(rateLimitManager).
-			OnAfterResponse(rateLimitManager.ResponseMiddleware).
-			SetBaseURL(baseURL).
-			SetRetryCount(5).
-			AddRetryCondition(isTooManyRequests).
-			// retry when create permission fails because scopes are created async-ly and sometimes (mainly in tests) the scope doesn't exist yet.
-			AddRetryCondition(func(r *resty.Response, err error) bool {
-				if err != nil {
-					return true
-				}
-				if !strings.Contains(r.Request.URL, "/permissions") {
-					return false
-				}
-				b := make(map[string]interface{})
-				err = json.Unmarshal(r.Body(), &b)
-				return err != nil || b["ok"] != true
-			}),
-	}
+	c := &PortClient{rateLimitManager: ratelimit.New(RateLimitOptionsFromEnv())}
+	c.Client = resty.New().
+		SetRateLimiter(c.rateLimitManager).
+		OnAfterResponse(c.rateLimitResponseMiddleware).
+		SetBaseURL(baseURL).
+		OnBeforeRequest(c.authenticateRequest).
+		SetRetryCount(DefaultRetryCount).
+		SetRetryWaitTime(DefaultRetryMinWait).
+		SetRetryMaxWaitTime(DefaultRetryMaxWait).
+		SetRetryAfter(retryAfter).
+		AddRetryCondition(isTooManyRequests).
+		AddRetryCondition(isRetryableGatewayError).
+		AddRetryCondition(c.isUnauthorized).
+		// retry when create permission fails because scopes are created async-ly and sometimes (mainly in tests) the scope doesn't exist yet.
+		AddRetryCondition(func(r *resty.Response, err error) bool {
+			if err != nil {
+				return true
+			}
+			if !strings.Contains(r.Request.URL, "/permissions") {
+				return false
+			}
+			b := make(map[string]interface{})
+			err = json.Unmarshal(r.Body(), &b)
+			return err != nil || b["ok"] != true
+		})
 
 	for _, opt := range opts {
 		opt(c)
 	}
+
+	transport, err := cassette.FromEnv(c.Client.GetClient().Transport, c.cassetteName)
+	if err != nil {
+		return nil, err
+	}
+	c.baseTransport = transport
+	c.Client.SetTransport(c.rateLimitManager.Transport(c.baseTransport))
 	return c, nil
 }
 
+// RateLimitOptionsFromEnv returns the rate limiting options set through the PORT_RATE_LIMIT_DISABLED,
+// PORT_DEBUG_RATE_LIMIT and PORT_RATE_LIMIT_SHARED_STATE_FILE environment variables.
+func RateLimitOptionsFromEnv() *ratelimit.Options {
+	opts := &ratelimit.Options{
+		Enabled:         utils.PtrTo(os.Getenv("PORT_RATE_LIMIT_DISABLED") == ""),
+		SharedStateFile: os.Getenv("PORT_RATE_LIMIT_SHARED_STATE_FILE"),
+	}
+	if isDebug := os.Getenv("PORT_DEBUG_RATE_LIMIT") != ""; isDebug {
+		opts.Logger = RateLimitDebugLogger()
+	}
+	return opts
+}
+
+// RateLimitDebugLogger returns a logger that writes the rate limiting debug logs to stderr.
+func RateLimitDebugLogger() *slog.Logger {
+	return slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelDebug}))
+}
+
+// rateLimitResponseMiddleware delegates to the current rate limit manager, which may be replaced by
+// WithRateLimitOptions after the middleware is registered.
+func (c *PortClient) rateLimitResponseMiddleware(client *resty.Client, resp *resty.Response) error {
+	return c.rateLimitManager.ResponseMiddleware(client, resp)
+}
+
 // FeatureFlags Fetches the feature flags from the Organization API. It caches the feature flags locally to reduce call
 // count.
 func (c *PortClient) FeatureFlags(ctx context.Context) ([]string, error) {
@@ -90,28 +142,127 @@ func (c *PortClient) HasFeatureFlags(ctx context.Context, flags ...string) (bool
 	return true, nil
 }
 
+// Authenticate exchanges the client ID and secret for an access token. The credentials are kept, so the token is
+// refreshed transparently when it nears its expiry or when a request fails with 401.
 func (c *PortClient) Authenticate(ctx context.Context, clientID, clientSecret string) (string, error) {
-	url := "v1/auth/access_token"
+	c.tokenMutex.Lock()
+	defer c.tokenMutex.Unlock()
+
+	c.ClientID = clientID
+	c.clientSecret = clientSecret
+	if err := c.refreshToken(ctx); err != nil {
+		return "", err
+	}
+	c.Client.SetAuthToken(c.accessToken)
+	return c.accessToken, nil
+}
+
+// refreshToken requests a new access token with the stored credentials. The caller must hold tokenMutex.
+func (c *PortClient) refreshToken(ctx context.Context) error {
+	tokenResp, err := c.requestAccessToken(ctx, c.ClientID, c.clientSecret)
+	if err != nil {
+		return err
+	}
+
+	c.accessToken = tokenResp.AccessToken
+	c.tokenIssuedAt = time.Now()
+	c.tokenExpiresAt = time.Time{}
+	if tokenResp.ExpiresIn > 0 {
+		c.tokenExpiresAt = c.tokenIssuedAt.Add(time.Duration(tokenResp.ExpiresIn) * time.Second)
+	}
+	return nil
+}
+
+// IssueAccessToken requests a new access token without changing the token the client uses. When clientID and
+// clientSecret are empty, the credentials the client was authenticated with are used.
+func (c *PortClient) IssueAccessToken(ctx context.Context, clientID, clientSecret string) (*AccessTokenResponse, error) {
+	if clientID == "" && clientSecret == "" {
+		c.tokenMutex.Lock()
+		clientID, clientSecret = c.ClientID, c.clientSecret
+		c.tokenMutex.Unlock()
+		if clientSecret == "" {
+			return nil, fmt.Errorf("the client wasn't authenticated with a client ID and secret")
+		}
+	}
+	return c.requestAccessToken(ctx, clientID, clientSecret)
+}
+
+func (c *PortClient) requestAccessToken(ctx context.Context, clientID, clientSecret string) (*AccessTokenResponse, error) {
 	resp, err := c.Client.R().
 		SetBody(map[string]interface{}{
 			"clientId":     clientID,
 			"clientSecret": clientSecret,
 		}).
 		SetContext(ctx).
-		Post(url)
+		Post(authURL)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 	var tokenResp AccessTokenResponse
 	err = json.Unmarshal(resp.Body(), &tokenResp)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 	if !tokenResp.Ok || tokenResp.AccessToken == "" {
-		return "", fmt.Errorf("failed to authenticate with Port, got: %s", resp.Body())
+		return nil, fmt.Errorf("failed to authenticate with Port, got: %s", resp.Body())
+	}
+	return &tokenResp, nil
+}
+
+// validToken returns the current access token, refreshing it first if it's about to expire. An empty token is
+// returned when the client wasn't authenticated with credentials, e.g. when a static token is used.
+func (c *PortClient) validToken(ctx context.Context) (string, error) {
+	c.tokenMutex.Lock()
+	defer c.tokenMutex.Unlock()
+
+	if c.clientSecret == "" {
+		return "", nil
+	}
+	if !c.tokenExpiresAt.IsZero() && time.Until(c.tokenExpiresAt) < tokenRefreshMargin {
+		if err := c.refreshToken(ctx); err != nil {
+			return "", fmt.Errorf("failed to refresh the Port access token: %w", err)
+		}
+	}
+	return c.accessToken, nil
+}
+
+// authenticateRequest sets the current access token on every request. The token is set on the request rather than
+// on the shared resty client, which isn't safe to modify while other requests are running.
+func (c *PortClient) authenticateRequest(_ *resty.Client, r *resty.Request) error {
+	if strings.HasSuffix(r.URL, authURL) {
+		return nil
+	}
+	token, err := c.validToken(r.Context())
+	if err != nil {
+		return err
+	}
+	if token != "" {
+		r.SetAuthToken(token)
 	}
-	c.Client.SetAuthToken(tokenResp.AccessToken)
-	return tokenResp.AccessToken, nil
+	return nil
+}
+
+// isUnauthorized refreshes the access token and retries the request when it was rejected with 401. If another request
+// already refreshed the token since this one was sent, the request is retried with the new token without refreshing
+// again.
+func (c *PortClient) isUnauthorized(r *resty.Response, _ error) bool {
+	if r == nil || r.Request == nil || r.StatusCode() != 401 || strings.HasSuffix(r.Request.URL, authURL) {
+		return false
+	}
+
+	c.tokenMutex.Lock()
+	defer c.tokenMutex.Unlock()
+
+	if c.clientSecret == "" {
+		return false
+	}
+	if r.Request.Token != c.accessToken {
+		return true
+	}
+	if time.Since(c.tokenIssuedAt) < minTokenRefreshInterval {
+		return false
+	}
+	return c.refreshToken(r.Request.Context()) == nil
 }
 
 func WithHeader(key, val string) Option {
@@ -120,12 +271,29 @@ func WithHeader(key, val string) Option {
 	}
 }
 
+// WithCassette records or replays the requests with the cassette called name, like the one of an acceptance test,
+// when a cassette directory is set in the environment.
+func WithCassette(name string) Option {
+	return func(pc *PortClient) {
+		pc.cassetteName = name
+	}
+}
+
 func WithClientID(clientID string) Option {
 	return func(pc *PortClient) {
 		pc.ClientID = clientID
 	}
 }
 
+// WithRateLimitOptions replaces the rate limiting options, which are read from the environment by default.
+func WithRateLimitOptions(opts *ratelimit.Options) Option {
+	return func(pc *PortClient) {
+		pc.rateLimitManager.Close()
+		pc.rateLimitManager = ratelimit.New(opts)
+		pc.Client.SetRateLimiter(pc.rateLimitManager)
+	}
+}
+
 func WithToken(token string) Option {
 	return func(pc *PortClient) {
 		pc.Client.SetAuthToken(token)
@@ -0,0 +1,145 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// tokenServer issues a new access token on every authentication and only accepts the latest one.
+type tokenServer struct {
+	*httptest.Server
+	authCount    atomic.Int32
+	currentToken atomic.Value
+}
+
+func newTokenServer(t *testing.T) *tokenServer {
+	s := &tokenServer{}
+	s.currentToken.Store("")
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/v1/auth/access_token" {
+			token := fmt.Sprintf("token-%d", s.authCount.Add(1))
+			s.currentToken.Store(token)
+			require.NoError(t, json.NewEncoder(w).Encode(AccessTokenResponse{Ok: true, AccessToken: token, ExpiresIn: 3600, TokenType: "Bearer"}))
+			return
+		}
+		if r.Header.Get("Authorization") != "Bearer "+s.currentToken.Load().(string) {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"ok":false,"error":"unauthorized"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"ok":true}`))
+	}))
+	return s
+}
+
+// revoke makes the server reject the token that was issued last, as if it had expired.
+func (s *tokenServer) revoke() {
+	s.currentToken.Store("revoked")
+}
+
+func TestTokenRefresh(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("refreshes and retries on 401", func(t *testing.T) {
+		server := newTokenServer(t)
+		defer server.Close()
+		c, err := New(server.URL)
+		require.NoError(t, err)
+		_, err = c.Authenticate(ctx, "id", "secret")
+		require.NoError(t, err)
+
+		c.tokenIssuedAt = time.Now().Add(-time.Hour)
+		server.revoke()
+
+		resp, err := c.Client.R().SetContext(ctx).Get("v1/organization")
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode())
+		assert.Equal(t, int32(2), server.authCount.Load())
+	})
+
+	t.Run("doesn't refresh a token that was just issued", func(t *testing.T) {
+		server := newTokenServer(t)
+		defer server.Close()
+		c, err := New(server.URL)
+		require.NoError(t, err)
+		_, err = c.Authenticate(ctx, "id", "secret")
+		require.NoError(t, err)
+
+		server.revoke()
+
+		resp, err := c.Client.R().SetContext(ctx).Get("v1/organization")
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusUnauthorized, resp.StatusCode())
+		assert.Equal(t, int32(1), server.authCount.Load())
+	})
+
+	t.Run("refreshes once before expiry for concurrent requests", func(t *testing.T) {
+		server := newTokenServer(t)
+		defer server.Close()
+		c, err := New(server.URL)
+		require.NoError(t, err)
+		_, err = c.Authenticate(ctx, "id", "secret")
+		require.NoError(t, err)
+
+		c.tokenExpiresAt = time.Now().Add(time.Minute)
+
+		var wg sync.WaitGroup
+		for range 10 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				resp, err := c.Client.R().SetContext(ctx).Get("v1/organization")
+				assert.NoError(t, err)
+				assert.Equal(t, http.StatusOK, resp.StatusCode())
+			}()
+		}
+		wg.Wait()
+		assert.Equal(t, int32(2), server.authCount.Load())
+	})
+
+	t.Run("doesn't refresh a static token", func(t *testing.T) {
+		server := newTokenServer(t)
+		defer server.Close()
+		c, err := New(server.URL, WithToken("static"))
+		require.NoError(t, err)
+
+		resp, err := c.Client.R().SetContext(ctx).Get("v1/organization")
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusUnauthorized, resp.StatusCode())
+		assert.Equal(t, int32(0), server.authCount.Load())
+	})
+}
+
+func TestIssueAccessToken(t *testing.T) {
+	ctx := context.Background()
+	server := newTokenServer(t)
+	defer server.Close()
+
+	c, err := New(server.URL)
+	require.NoError(t, err)
+	_, err = c.IssueAccessToken(ctx, "", "")
+	assert.Error(t, err, "a client without credentials can't issue tokens with them")
+
+	token, err := c.Authenticate(ctx, "id", "secret")
+	require.NoError(t, err)
+
+	issued, err := c.IssueAccessToken(ctx, "", "")
+	require.NoError(t, err)
+	assert.NotEqual(t, token, issued.AccessToken)
+	assert.Equal(t, int64(3600), issued.ExpiresIn)
+
+	c.tokenMutex.Lock()
+	assert.Equal(t, token, c.accessToken, "issuing a token shouldn't replace the client's token")
+	c.tokenMutex.Unlock()
+}
@@ -6,13 +6,14 @@ import (
 	"fmt"
 )
 
-func (c *PortClient) ReadEntity(ctx context.Context, id string, blueprint string) (*Entity, int, error) {
+// ReadEntity reads a single entity. Resources should always exclude the calculated properties, as they are
+// calculated by the backend and not part of the state, pulling them would cause a diff.
+func (c *PortClient) ReadEntity(ctx context.Context, id string, blueprint string, excludeCalculatedProperties bool) (*Entity, int, error) {
 	url := "v1/blueprints/{blueprint}/entities/{identifier}"
 	resp, err := c.Client.R().
+		SetContext(ctx).
 		SetHeader("Accept", "application/json").
-		// we don't want to include those properties as they are calculated by the backend
-		// and not part of the state, pulling them would cause a diff
-		SetQueryParam("exclude_calculated_properties", "true").
+		SetQueryParam("exclude_calculated_properties", fmt.Sprintf("%t", excludeCalculatedProperties)).
 		SetPathParam(("blueprint"), blueprint).
 		SetPathParam("identifier", id).
 		Get(url)
@@ -34,6 +35,7 @@ func (c *PortClient) CreateEntity(ctx context.Context, e *Entity, runID string,
 	url := "v1/blueprints/{blueprint}/entities"
 	pb := &PortBody{}
 	req := c.Client.R().
+		SetContext(ctx).
 		SetBody(e).
 		SetPathParam(("blueprint"), e.Blueprint).
 		SetQueryParam("upsert", "true").
@@ -56,10 +58,38 @@ func (c *PortClient) CreateEntity(ctx context.Context, e *Entity, runID string,
 	return &pb.Entity, nil
 }
 
+// UpsertEntities creates or updates entities of a blueprint in a single request. Entities that fail validation don't
+// fail the request, they are reported in the errors of the result instead.
+func (c *PortClient) UpsertEntities(ctx context.Context, blueprint string, entities []Entity, createMissingRelatedEntities bool) (*BulkEntitiesResult, error) {
+	url := "v1/blueprints/{blueprint}/entities/bulk"
+	result := &BulkEntitiesResult{}
+	req := c.Client.R().
+		SetContext(ctx).
+		SetBody(map[string]any{"entities": entities}).
+		SetPathParam("blueprint", blueprint).
+		SetQueryParam("upsert", "true").
+		SetResult(result)
+
+	if createMissingRelatedEntities {
+		req.SetQueryParam("create_missing_related_entities", "true")
+	}
+
+	resp, err := req.Post(url)
+	if err != nil {
+		return nil, err
+	}
+
+	if !result.OK {
+		return nil, fmt.Errorf("failed to upsert entities, got: %s", resp.Body())
+	}
+	return result, nil
+}
+
 func (c *PortClient) UpdateEntity(ctx context.Context, id string, blueprint string, e *Entity, runID string, createMissingRelatedEntities bool) (*Entity, error) {
 	url := "v1/blueprints/{blueprint}/entities/{identifier}"
 	pb := &PortBody{}
 	req := c.Client.R().
+		SetContext(ctx).
 		SetBody(e).
 		SetPathParam(("blueprint"), e.Blueprint).
 		SetPathParam("identifier", id).
@@ -81,10 +111,38 @@ func (c *PortClient) UpdateEntity(ctx context.Context, id string, blueprint stri
 	return &pb.Entity, nil
 }
 
+// PatchEntity updates only the fields, properties and relations the entity has, and leaves the others as they are.
+func (c *PortClient) PatchEntity(ctx context.Context, id string, blueprint string, e *Entity, runID string, createMissingRelatedEntities bool) (*Entity, error) {
+	url := "v1/blueprints/{blueprint}/entities/{identifier}"
+	pb := &PortBody{}
+	req := c.Client.R().
+		SetContext(ctx).
+		SetBody(e).
+		SetPathParam("blueprint", blueprint).
+		SetPathParam("identifier", id).
+		SetQueryParam("run_id", runID).
+		SetResult(&pb)
+
+	if createMissingRelatedEntities {
+		req.SetQueryParam("create_missing_related_entities", "true")
+	}
+
+	resp, err := req.Patch(url)
+	if err != nil {
+		return nil, err
+	}
+
+	if !pb.OK {
+		return nil, fmt.Errorf("failed to patch entity, got: %s", resp.Body())
+	}
+	return &pb.Entity, nil
+}
+
 func (c *PortClient) DeleteEntity(ctx context.Context, id string, blueprint string) error {
 	url := "v1/blueprints/{blueprint}/entities/{identifier}"
 	pb := &PortBody{}
 	resp, err := c.Client.R().
+		SetContext(ctx).
 		SetHeader("Accept", "application/json").
 		SetPathParam("blueprint", blueprint).
 		SetPathParam("identifier", id).
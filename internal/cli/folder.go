@@ -46,6 +46,37 @@ func (c *PortClient) GetFolder(ctx context.Context, id string) (*Folder, int, er
 	return nil, resp.StatusCode(), fmt.Errorf("folder with identifier %s not found", id)
 }
 
+// ReadFolders reads every folder of the catalog sidebar.
+func (c *PortClient) ReadFolders(ctx context.Context) ([]Folder, error) {
+	encodedSidebarId := url.QueryEscape(sidebarId)
+	sb := &SidebarGetResponseDTO{}
+	url := fmt.Sprintf("%s/%s", sidebarRoute, encodedSidebarId)
+	resp, err := c.Client.R().
+		SetContext(ctx).
+		SetHeader("Accept", "application/json").
+		SetResult(sb).
+		Get(url)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode() != 200 {
+		return nil, fmt.Errorf("failed to get sidebar, got: %s", resp.Body())
+	}
+	var folders []Folder
+	for _, item := range sb.Sidebar.Items {
+		if item.SidebarType == "folder" {
+			folders = append(folders, Folder{
+				Identifier: item.Identifier,
+				Sidebar:    sidebarId,
+				Title:      item.Title,
+				After:      item.After,
+				Parent:     item.Parent,
+			})
+		}
+	}
+	return folders, nil
+}
+
 func (c *PortClient) CreateFolder(ctx context.Context, folder *Folder) (*Folder, error) {
 	url := fmt.Sprintf("%s/%s/folders", sidebarRoute, sidebarId)
 
@@ -7,8 +7,9 @@ import (
 )
 
 type PortBodyForIntegration struct {
-	OK          bool        `json:"ok"`
-	Integration Integration `json:"integration"`
+	OK           bool          `json:"ok"`
+	Integration  Integration   `json:"integration"`
+	Integrations []Integration `json:"integrations"`
 }
 
 func (c *PortClient) GetIntegration(ctx context.Context, id string) (*Integration, error) {
@@ -30,6 +31,23 @@ func (c *PortClient) GetIntegration(ctx context.Context, id string) (*Integratio
 	return &pb.Integration, nil
 }
 
+func (c *PortClient) ReadIntegrations(ctx context.Context) ([]Integration, error) {
+	pb := &PortBodyForIntegration{}
+	url := "v1/integration"
+	resp, err := c.Client.R().
+		SetContext(ctx).
+		SetHeader("Accept", "application/json").
+		SetResult(pb).
+		Get(url)
+	if err != nil {
+		return nil, err
+	}
+	if !pb.OK {
+		return nil, fmt.Errorf("failed to read integrations, got: %s", resp.Body())
+	}
+	return pb.Integrations, nil
+}
+
 func (c *PortClient) UpdateIntegration(ctx context.Context, id string, integration *Integration) (*Integration, error) {
 	url := "v1/integration/{identifier}"
 
@@ -94,3 +112,23 @@ func (c *PortClient) DeleteIntegration(ctx context.Context, id string) (int, err
 	}
 	return resp.StatusCode(), nil
 }
+
+// ResyncIntegration asks the integration to resync, which it does asynchronously. Its progress is reported in the
+// ResyncState of the integration.
+func (c *PortClient) ResyncIntegration(ctx context.Context, id string) error {
+	pb := &PortBodyForIntegration{}
+	url := "v1/integration/{identifier}/resync"
+	resp, err := c.Client.R().
+		SetContext(ctx).
+		SetHeader("Accept", "application/json").
+		SetResult(pb).
+		SetPathParam("identifier", id).
+		Post(url)
+	if err != nil {
+		return err
+	}
+	if !pb.OK {
+		return fmt.Errorf("failed to resync integration, got: %s", resp.Body())
+	}
+	return nil
+}
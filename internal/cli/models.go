@@ -538,8 +538,31 @@ type (
 		Status          string `json:"status,omitempty"`
 		DeleteBlueprint bool   `json:"deleteBlueprint,omitempty"`
 		DeleteEntities  bool   `json:"deleteEntities,omitempty"`
-		FailureCount    int    `json:"failureCount,omitempty"`
-		SuccessCount    int    `json:"successCount,omitempty"`
+		FailureCount    *int   `json:"failureCount,omitempty"`
+		SuccessCount    *int   `json:"successCount,omitempty"`
+	}
+
+	ActionRun struct {
+		Meta
+		Id          string  `json:"id,omitempty"`
+		Status      string  `json:"status,omitempty"`
+		StatusLabel *string `json:"statusLabel,omitempty"`
+	}
+
+	ActionRunRequest struct {
+		Properties map[string]any `json:"properties"`
+		Entity     *string        `json:"entity,omitempty"`
+	}
+
+	WorkflowRun struct {
+		Meta
+		Identifier string `json:"identifier,omitempty"`
+		Status     string `json:"status,omitempty"`
+	}
+
+	WorkflowRunRequest struct {
+		Inputs map[string]any `json:"inputs"`
+		Entity *string        `json:"entity,omitempty"`
 	}
 
 	SearchRequestQuery struct {
@@ -548,6 +571,7 @@ type (
 		Include                     []string        `json:"include,omitempty"`
 		Exclude                     []string        `json:"exclude,omitempty"`
 		AttachTitleToRelation       *bool           `json:"attach_title_to_relation,omitempty"`
+		MaxResults                  *int            `json:"-"`
 	}
 
 	Folder struct {
@@ -680,18 +704,25 @@ type PortBody struct {
 	OK                   bool              `json:"ok"`
 	Entity               Entity            `json:"entity"`
 	Blueprint            Blueprint         `json:"blueprint"`
+	Blueprints           []Blueprint       `json:"blueprints"`
 	BlueprintPermissions Blueprint         `json:"blueprint_permissions"`
 	Action               Action            `json:"action"`
+	Actions              []Action          `json:"actions"`
 	ActionPermissions    ActionPermissions `json:"permissions"`
 	Webhook              Webhook           `json:"integration"`
+	Webhooks             []Webhook         `json:"integrations"`
 	Scorecard            Scorecard         `json:"Scorecard"`
+	Scorecards           []Scorecard       `json:"scorecards"`
 	Team                 PortTeam          `json:"team"`
 	Page                 Page              `json:"page"`
+	Pages                []Page            `json:"pages"`
 	MigrationId          string            `json:"migrationId"`
 	Migration            Migration         `json:"migration"`
 	Folder               Folder            `json:"folder"`
 	Organization         *Organization     `json:"organization"`
 	Workflow             Workflow          `json:"workflow"`
+	Run                  ActionRun         `json:"run"`
+	WorkflowRun          WorkflowRun       `json:"workflowRun"`
 }
 
 type SearchEntityResult struct {
@@ -708,6 +739,30 @@ type SearchResult struct {
 	OK                 bool     `json:"ok"`
 	MatchingBlueprints []string `json:"matchingBlueprints"`
 	Entities           []Entity `json:"entities"`
+	Next               *string  `json:"next,omitempty"`
+	Truncated          bool     `json:"-"`
+}
+
+// BulkEntitiesResult is the response of the bulk entities route, which upserts the valid entities of a request and
+// reports the others in its errors. Both are matched to the request by their index.
+type BulkEntitiesResult struct {
+	OK       bool               `json:"ok"`
+	Entities []BulkEntityResult `json:"entities"`
+	Errors   []BulkEntityError  `json:"errors"`
+}
+
+type BulkEntityResult struct {
+	Identifier string `json:"identifier"`
+	Index      int    `json:"index"`
+	Created    bool   `json:"created"`
+}
+
+type BulkEntityError struct {
+	Identifier string `json:"identifier"`
+	Index      int    `json:"index"`
+	StatusCode int    `json:"statusCode"`
+	Error      string `json:"error"`
+	Message    string `json:"message"`
 }
 
 type PortPagePermissionsBody struct {
@@ -738,13 +793,30 @@ type PortTeamBody struct {
 	Team TeamPortBody `json:"team"`
 }
 
+type PortTeamsBody struct {
+	OK    bool           `json:"ok"`
+	Teams []TeamPortBody `json:"teams"`
+}
+
 type PortProviderModel struct {
-	ClientId                              types.String `tfsdk:"client_id"`
-	Secret                                types.String `tfsdk:"secret"`
-	Token                                 types.String `tfsdk:"token"`
-	BaseUrl                               types.String `tfsdk:"base_url"`
-	JSONEscapeHTML                        types.Bool   `tfsdk:"json_escape_html"`
-	BlueprintPropertyTypeChangeProtection types.Bool   `tfsdk:"blueprint_property_type_change_protection"`
+	ClientId                              types.String    `tfsdk:"client_id"`
+	Secret                                types.String    `tfsdk:"secret"`
+	Token                                 types.String    `tfsdk:"token"`
+	BaseUrl                               types.String    `tfsdk:"base_url"`
+	JSONEscapeHTML                        types.Bool      `tfsdk:"json_escape_html"`
+	BlueprintPropertyTypeChangeProtection types.Bool      `tfsdk:"blueprint_property_type_change_protection"`
+	RetryCount                            types.Int64     `tfsdk:"retry_count"`
+	RetryMinWaitMs                        types.Int64     `tfsdk:"retry_min_wait_ms"`
+	RetryMaxWaitMs                        types.Int64     `tfsdk:"retry_max_wait_ms"`
+	RateLimit                             *RateLimitModel `tfsdk:"rate_limit"`
+}
+
+type RateLimitModel struct {
+	Enabled         types.Bool   `tfsdk:"enabled"`
+	MinIntervalMs   types.Int64  `tfsdk:"min_interval_ms"`
+	MaxConcurrency  types.Int64  `tfsdk:"max_concurrency"`
+	Debug           types.Bool   `tfsdk:"debug"`
+	SharedStateFile types.String `tfsdk:"shared_state_file"`
 }
 
 type PortBodyDelete struct {
@@ -758,6 +830,22 @@ type Integration struct {
 	Version              *string               `json:"version"`
 	Config               *map[string]any       `json:"config"`
 	ChangelogDestination *ChangelogDestination `json:"changelogDestination,omitempty"`
+	ResyncState          *ResyncState          `json:"resyncState,omitempty"`
+}
+
+// ResyncState is the state of the last resync of an integration, as the integration reports it.
+type ResyncState struct {
+	Status          string         `json:"status,omitempty"`
+	LastResyncStart *time.Time     `json:"lastResyncStart,omitempty"`
+	LastResyncEnd   *time.Time     `json:"lastResyncEnd,omitempty"`
+	Metrics         *ResyncMetrics `json:"metrics,omitempty"`
+}
+
+type ResyncMetrics struct {
+	Upserted int      `json:"upserted"`
+	Deleted  int      `json:"deleted"`
+	Failed   int      `json:"failed"`
+	Errors   []string `json:"errors,omitempty"`
 }
 
 type Organization struct {
@@ -25,6 +25,23 @@ func (c *PortClient) GetPage(ctx context.Context, pageId string) (*Page, int, er
 
 }
 
+func (c *PortClient) ReadPages(ctx context.Context) ([]Page, error) {
+	pb := &PortBody{}
+	url := "v1/pages"
+	resp, err := c.Client.R().
+		SetContext(ctx).
+		SetHeader("Accept", "application/json").
+		SetResult(pb).
+		Get(url)
+	if err != nil {
+		return nil, err
+	}
+	if !pb.OK {
+		return nil, fmt.Errorf("failed to read pages, got: %s", resp.Body())
+	}
+	return pb.Pages, nil
+}
+
 func (c *PortClient) CreatePage(ctx context.Context, page *Page) (*Page, error) {
 	url := "v1/pages"
 	resp, err := c.Client.R().
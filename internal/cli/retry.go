@@ -0,0 +1,87 @@
+package cli
+
+import (
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+const (
+	DefaultRetryCount   = 5
+	DefaultRetryMinWait = 500 * time.Millisecond
+	DefaultRetryMaxWait = 30 * time.Second
+)
+
+// idempotentMethods are the methods that are safe to retry when a gateway error leaves it unknown whether the request
+// reached Port.
+var idempotentMethods = []string{http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete}
+
+func isTooManyRequests(r *resty.Response, _ error) bool {
+	return r.StatusCode() == http.StatusTooManyRequests
+}
+
+func isRetryableGatewayError(r *resty.Response, _ error) bool {
+	if r == nil || r.Request == nil {
+		return false
+	}
+	switch r.StatusCode() {
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return slices.Contains(idempotentMethods, strings.ToUpper(r.Request.Method))
+	default:
+		return false
+	}
+}
+
+// retryAfter returns how long to wait before retrying, as requested by Port through the Retry-After header or, for
+// rate limited requests, the x-ratelimit-reset header. When neither is present it returns 0, which makes resty fall
+// back to a jittered exponential backoff between the minimum and maximum retry wait times.
+func retryAfter(_ *resty.Client, r *resty.Response) (time.Duration, error) {
+	if r == nil {
+		return 0, nil
+	}
+	if wait, ok := parseRetryAfter(r.Header().Get("Retry-After"), time.Now()); ok {
+		return wait, nil
+	}
+	if r.StatusCode() == http.StatusTooManyRequests {
+		if reset, err := strconv.Atoi(strings.TrimSpace(r.Header().Get("x-ratelimit-reset"))); err == nil && reset > 0 {
+			return time.Duration(reset) * time.Second, nil
+		}
+	}
+	return 0, nil
+}
+
+// parseRetryAfter parses a Retry-After header, which holds either the amount of seconds to wait or an HTTP date.
+func parseRetryAfter(header string, now time.Time) (time.Duration, bool) {
+	header = strings.TrimSpace(header)
+	if header == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(header); err == nil {
+		if seconds <= 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(header); err == nil {
+		if wait := date.Sub(now); wait > 0 {
+			return wait, true
+		}
+	}
+	return 0, false
+}
+
+func WithRetryCount(count int) Option {
+	return func(pc *PortClient) {
+		pc.Client.SetRetryCount(count)
+	}
+}
+
+func WithRetryWaitTime(minWait, maxWait time.Duration) Option {
+	return func(pc *PortClient) {
+		pc.Client.SetRetryWaitTime(minWait).SetRetryMaxWaitTime(maxWait)
+	}
+}
@@ -0,0 +1,126 @@
+package cli
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		header   string
+		wantWait time.Duration
+		wantOk   bool
+	}{
+		{name: "empty", header: ""},
+		{name: "seconds", header: "3", wantWait: 3 * time.Second, wantOk: true},
+		{name: "zero seconds", header: "0"},
+		{name: "negative seconds", header: "-1"},
+		{name: "http date", header: now.Add(10 * time.Second).Format(http.TimeFormat), wantWait: 10 * time.Second, wantOk: true},
+		{name: "http date in the past", header: now.Add(-10 * time.Second).Format(http.TimeFormat)},
+		{name: "invalid", header: "soon"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			wait, ok := parseRetryAfter(tt.header, now)
+			assert.Equal(t, tt.wantOk, ok)
+			assert.Equal(t, tt.wantWait, wait)
+		})
+	}
+}
+
+func TestRetries(t *testing.T) {
+	ctx := context.Background()
+
+	// newFlakyServer fails the first `failures` requests with the given status and headers.
+	newFlakyServer := func(status, failures int, headers map[string]string, requests *atomic.Int32) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			if int(requests.Add(1)) <= failures {
+				for k, v := range headers {
+					w.Header().Set(k, v)
+				}
+				w.WriteHeader(status)
+				_, _ = w.Write([]byte(`{"ok":false}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"ok":true}`))
+		}))
+	}
+
+	tests := []struct {
+		name         string
+		method       string
+		status       int
+		headers      map[string]string
+		wantStatus   int
+		wantRequests int32
+		wantMinWait  time.Duration
+	}{
+		{name: "retries gateway errors of idempotent requests", method: http.MethodGet, status: http.StatusBadGateway, wantStatus: http.StatusOK, wantRequests: 3},
+		{name: "retries service unavailable of delete requests", method: http.MethodDelete, status: http.StatusServiceUnavailable, wantStatus: http.StatusOK, wantRequests: 3},
+		{name: "doesn't retry gateway errors of post requests", method: http.MethodPost, status: http.StatusGatewayTimeout, wantStatus: http.StatusGatewayTimeout, wantRequests: 1},
+		{name: "doesn't retry internal server errors", method: http.MethodGet, status: http.StatusInternalServerError, wantStatus: http.StatusInternalServerError, wantRequests: 1},
+		{name: "retries rate limited post requests", method: http.MethodPost, status: http.StatusTooManyRequests, wantStatus: http.StatusOK, wantRequests: 3},
+		{
+			name:         "honors retry after",
+			method:       http.MethodGet,
+			status:       http.StatusServiceUnavailable,
+			headers:      map[string]string{"Retry-After": "1"},
+			wantStatus:   http.StatusOK,
+			wantRequests: 3,
+			wantMinWait:  2 * time.Second,
+		},
+		{
+			name:         "honors rate limit reset",
+			method:       http.MethodPost,
+			status:       http.StatusTooManyRequests,
+			headers:      map[string]string{"x-ratelimit-reset": "1"},
+			wantStatus:   http.StatusOK,
+			wantRequests: 3,
+			wantMinWait:  2 * time.Second,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requests atomic.Int32
+			server := newFlakyServer(tt.status, 2, tt.headers, &requests)
+			defer server.Close()
+
+			c, err := New(server.URL, WithRetryWaitTime(time.Millisecond, 5*time.Second))
+			require.NoError(t, err)
+
+			start := time.Now()
+			resp, err := c.Client.R().SetContext(ctx).Execute(tt.method, "v1/blueprints")
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantStatus, resp.StatusCode())
+			assert.Equal(t, tt.wantRequests, requests.Load())
+			assert.GreaterOrEqual(t, time.Since(start), tt.wantMinWait)
+		})
+	}
+
+	t.Run("retry count", func(t *testing.T) {
+		var requests atomic.Int32
+		server := newFlakyServer(http.StatusBadGateway, 10, nil, &requests)
+		defer server.Close()
+
+		c, err := New(server.URL, WithRetryCount(1), WithRetryWaitTime(time.Millisecond, time.Millisecond))
+		require.NoError(t, err)
+
+		resp, err := c.Client.R().SetContext(ctx).Get("v1/blueprints")
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusBadGateway, resp.StatusCode())
+		assert.Equal(t, int32(2), requests.Load())
+	})
+}
@@ -0,0 +1,85 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+func (c *PortClient) CreateActionRun(ctx context.Context, actionID string, run *ActionRunRequest) (*ActionRun, error) {
+	url := "v1/actions/{action_identifier}/runs"
+	resp, err := c.Client.R().
+		SetBody(run).
+		SetContext(ctx).
+		SetPathParam("action_identifier", actionID).
+		Post(url)
+	if err != nil {
+		return nil, err
+	}
+	var pb PortBody
+	err = json.Unmarshal(resp.Body(), &pb)
+	if err != nil {
+		return nil, err
+	}
+	if !pb.OK {
+		return nil, fmt.Errorf("failed to run action, got (HTTP %d): %s", resp.StatusCode(), resp.Body())
+	}
+	return &pb.Run, nil
+}
+
+func (c *PortClient) ReadActionRun(ctx context.Context, runID string) (*ActionRun, error) {
+	pb := &PortBody{}
+	url := "v1/actions/runs/{run_id}"
+	resp, err := c.Client.R().
+		SetContext(ctx).
+		SetHeader("Accept", "application/json").
+		SetResult(pb).
+		SetPathParam("run_id", runID).
+		Get(url)
+	if err != nil {
+		return nil, err
+	}
+	if !pb.OK {
+		return nil, fmt.Errorf("failed to read action run, got: %s", resp.Body())
+	}
+	return &pb.Run, nil
+}
+
+func (c *PortClient) CreateWorkflowRun(ctx context.Context, workflowID string, run *WorkflowRunRequest) (*WorkflowRun, error) {
+	url := "v1/workflows/{workflow_identifier}/runs"
+	resp, err := c.Client.R().
+		SetBody(run).
+		SetContext(ctx).
+		SetPathParam("workflow_identifier", workflowID).
+		Post(url)
+	if err != nil {
+		return nil, err
+	}
+	var pb PortBody
+	err = json.Unmarshal(resp.Body(), &pb)
+	if err != nil {
+		return nil, err
+	}
+	if !pb.OK {
+		return nil, fmt.Errorf("failed to run workflow, got (HTTP %d): %s", resp.StatusCode(), resp.Body())
+	}
+	return &pb.WorkflowRun, nil
+}
+
+func (c *PortClient) ReadWorkflowRun(ctx context.Context, runID string) (*WorkflowRun, error) {
+	pb := &PortBody{}
+	url := "v1/workflows/runs/{run_identifier}"
+	resp, err := c.Client.R().
+		SetContext(ctx).
+		SetHeader("Accept", "application/json").
+		SetResult(pb).
+		SetPathParam("run_identifier", runID).
+		Get(url)
+	if err != nil {
+		return nil, err
+	}
+	if !pb.OK {
+		return nil, fmt.Errorf("failed to read workflow run, got: %s", resp.Body())
+	}
+	return &pb.WorkflowRun, nil
+}
@@ -25,6 +25,23 @@ func (c *PortClient) ReadScorecard(ctx context.Context, blueprintID string, scor
 	return &pb.Scorecard, resp.StatusCode(), nil
 }
 
+func (c *PortClient) ReadScorecards(ctx context.Context) ([]Scorecard, error) {
+	pb := &PortBody{}
+	url := "v1/scorecards"
+	resp, err := c.Client.R().
+		SetContext(ctx).
+		SetHeader("Accept", "application/json").
+		SetResult(pb).
+		Get(url)
+	if err != nil {
+		return nil, err
+	}
+	if !pb.OK {
+		return nil, fmt.Errorf("failed to read scorecards, got: %s", resp.Body())
+	}
+	return pb.Scorecards, nil
+}
+
 func (c *PortClient) CreateScorecard(ctx context.Context, blueprintID string, scorecard *Scorecard) (*Scorecard, error) {
 	url := "v1/blueprints/{blueprint_identifier}/scorecards"
 	resp, err := c.Client.R().
@@ -4,19 +4,106 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"maps"
+	"slices"
 	"strings"
 )
 
+// searchPageLimit is the amount of entities requested in every page of the search API.
+const searchPageLimit = 1000
+
+// Search queries entities, following the search API's `next` cursor until every matching entity was fetched or
+// MaxResults entities were collected. A `limit` in the query caps the results the same way, instead of setting the
+// size of the pages. When matching entities were left out because of the cap, Truncated is set on the result.
 func (c *PortClient) Search(ctx context.Context, searchRequest *SearchRequestQuery) (*SearchResult, error) {
+	maxResults, err := searchMaxResults(searchRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &SearchResult{OK: true, Entities: []Entity{}, MatchingBlueprints: []string{}}
+	var from *string
+	for {
+		limit := searchPageLimit
+		if maxResults != nil {
+			// One more entity than the cap is requested, to tell whether any entity is left out.
+			limit = min(limit, *maxResults-len(result.Entities)+1)
+		}
+
+		page, err := c.searchPage(ctx, searchRequest, limit, from)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, blueprint := range page.MatchingBlueprints {
+			if !slices.Contains(result.MatchingBlueprints, blueprint) {
+				result.MatchingBlueprints = append(result.MatchingBlueprints, blueprint)
+			}
+		}
+		result.Entities = append(result.Entities, page.Entities...)
+
+		if maxResults != nil && len(result.Entities) > *maxResults {
+			result.Truncated = true
+			result.Entities = result.Entities[:*maxResults]
+			return result, nil
+		}
+		if page.Next == nil || *page.Next == "" || len(page.Entities) == 0 {
+			return result, nil
+		}
+		from = page.Next
+	}
+}
+
+// searchMaxResults returns the maximum amount of entities a search collects, the lower of MaxResults and the `limit`
+// of the query.
+func searchMaxResults(searchRequest *SearchRequestQuery) (*int, error) {
+	maxResults := searchRequest.MaxResults
+	if searchRequest.Query == nil {
+		return maxResults, nil
+	}
+	queryLimit, ok := (*searchRequest.Query)["limit"]
+	if !ok {
+		return maxResults, nil
+	}
+
+	var limit int
+	switch l := queryLimit.(type) {
+	case int:
+		limit = l
+	case float64:
+		limit = int(l)
+		if float64(limit) != l {
+			limit = 0
+		}
+	}
+	if limit < 1 {
+		return nil, fmt.Errorf("the limit of the search query must be a positive integer, got %v", queryLimit)
+	}
+	if maxResults != nil && *maxResults < limit {
+		return maxResults, nil
+	}
+	return &limit, nil
+}
+
+func (c *PortClient) searchPage(ctx context.Context, searchRequest *SearchRequestQuery, limit int, from *string) (*SearchResult, error) {
 	url := "v1/entities/search"
 
+	body := map[string]any{}
+	if searchRequest.Query != nil {
+		body = maps.Clone(*searchRequest.Query)
+	}
+	body["limit"] = limit
+	if from != nil {
+		body["from"] = *from
+	}
+
 	req := c.Client.R().
 		SetContext(ctx).
-		SetBody(*searchRequest.Query).
+		SetBody(body).
 		SetHeader("Accept", "application/json")
 
 	if searchRequest.ExcludeCalculatedProperties != nil {
-		req.SetQueryParam("exclude_calculated_properties", fmt.Sprintf("%v", &searchRequest.ExcludeCalculatedProperties))
+		req.SetQueryParam("exclude_calculated_properties", fmt.Sprintf("%v", *searchRequest.ExcludeCalculatedProperties))
 	}
 
 	if len(searchRequest.Include) > 0 {
@@ -28,7 +115,7 @@ func (c *PortClient) Search(ctx context.Context, searchRequest *SearchRequestQue
 	}
 
 	if searchRequest.AttachTitleToRelation != nil {
-		req.SetQueryParam("attach_title_to_relation", fmt.Sprintf("%v", &searchRequest.AttachTitleToRelation))
+		req.SetQueryParam("attach_title_to_relation", fmt.Sprintf("%v", *searchRequest.AttachTitleToRelation))
 	}
 
 	resp, err := req.Post(url)
@@ -0,0 +1,176 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newPagedSearchServer serves totalEntities entities, honoring the limit and from cursor of the search API. With
+// trailingNext, a page that ends at the last entity still has a `next` cursor, to an empty page.
+func newPagedSearchServer(t *testing.T, totalEntities int, trailingNext bool, requests *[]map[string]any) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]any
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		*requests = append(*requests, body)
+
+		start := 0
+		if from, ok := body["from"].(string); ok {
+			start, _ = strconv.Atoi(from)
+		}
+		end := min(start+int(body["limit"].(float64)), totalEntities)
+
+		entities := make([]Entity, 0, end-start)
+		for i := start; i < end; i++ {
+			entities = append(entities, Entity{Identifier: fmt.Sprintf("e%d", i), Blueprint: "service"})
+		}
+		result := map[string]any{
+			"ok":                 true,
+			"matchingBlueprints": []string{"service"},
+			"entities":           entities,
+		}
+		if end < totalEntities || (trailingNext && end > start) {
+			result["next"] = strconv.Itoa(end)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		require.NoError(t, json.NewEncoder(w).Encode(result))
+	}))
+}
+
+func TestSearchPagination(t *testing.T) {
+	tests := []struct {
+		name             string
+		totalEntities    int
+		trailingNext     bool
+		maxResults       *int
+		queryLimit       any
+		wantErr          bool
+		wantEntities     int
+		wantTruncated    bool
+		wantRequests     int
+		wantLastPageSize int
+	}{
+		{
+			name:             "single page",
+			totalEntities:    10,
+			wantEntities:     10,
+			wantRequests:     1,
+			wantLastPageSize: searchPageLimit,
+		},
+		{
+			name:             "follows the cursor until the last page",
+			totalEntities:    2*searchPageLimit + 5,
+			wantEntities:     2*searchPageLimit + 5,
+			wantRequests:     3,
+			wantLastPageSize: searchPageLimit,
+		},
+		{
+			name:             "max results truncates",
+			totalEntities:    2*searchPageLimit + 5,
+			maxResults:       utils.PtrTo(searchPageLimit + 10),
+			wantEntities:     searchPageLimit + 10,
+			wantTruncated:    true,
+			wantRequests:     2,
+			wantLastPageSize: 11,
+		},
+		{
+			name:             "max results equal to the total is not truncated",
+			totalEntities:    20,
+			maxResults:       utils.PtrTo(20),
+			wantEntities:     20,
+			wantRequests:     1,
+			wantLastPageSize: 21,
+		},
+		{
+			name:             "max results equal to the total with a next cursor to an empty page is not truncated",
+			totalEntities:    searchPageLimit,
+			trailingNext:     true,
+			maxResults:       utils.PtrTo(searchPageLimit),
+			wantEntities:     searchPageLimit,
+			wantRequests:     2,
+			wantLastPageSize: 1,
+		},
+		{
+			name:             "max results above the total",
+			totalEntities:    20,
+			maxResults:       utils.PtrTo(50),
+			wantEntities:     20,
+			wantRequests:     1,
+			wantLastPageSize: 51,
+		},
+		{
+			name:             "the query limit caps the results",
+			totalEntities:    2*searchPageLimit + 5,
+			queryLimit:       float64(searchPageLimit + 10),
+			wantEntities:     searchPageLimit + 10,
+			wantTruncated:    true,
+			wantRequests:     2,
+			wantLastPageSize: 11,
+		},
+		{
+			name:             "the lower of the query limit and max results caps the results",
+			totalEntities:    50,
+			maxResults:       utils.PtrTo(30),
+			queryLimit:       10,
+			wantEntities:     10,
+			wantTruncated:    true,
+			wantRequests:     1,
+			wantLastPageSize: 11,
+		},
+		{
+			name:          "an invalid query limit is rejected",
+			totalEntities: 50,
+			queryLimit:    "10",
+			wantErr:       true,
+		},
+		{
+			name:          "a query limit that isn't positive is rejected",
+			totalEntities: 50,
+			queryLimit:    float64(0),
+			wantErr:       true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requests []map[string]any
+			server := newPagedSearchServer(t, tt.totalEntities, tt.trailingNext, &requests)
+			defer server.Close()
+
+			c, err := New(server.URL)
+			require.NoError(t, err)
+
+			query := map[string]any{"combinator": "and", "rules": []any{}}
+			if tt.queryLimit != nil {
+				query["limit"] = tt.queryLimit
+			}
+			result, err := c.Search(context.Background(), &SearchRequestQuery{Query: &query, MaxResults: tt.maxResults})
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Empty(t, requests)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Len(t, result.Entities, tt.wantEntities)
+			assert.Equal(t, tt.wantTruncated, result.Truncated)
+			assert.Equal(t, []string{"service"}, result.MatchingBlueprints)
+			require.Len(t, requests, tt.wantRequests)
+			assert.Equal(t, float64(tt.wantLastPageSize), requests[len(requests)-1]["limit"])
+			assert.Equal(t, "and", requests[0]["combinator"])
+			assert.NotContains(t, requests[0], "from")
+			assert.Equal(t, tt.queryLimit, query["limit"], "the caller's query must not be modified")
+			for i, e := range result.Entities {
+				assert.Equal(t, fmt.Sprintf("e%d", i), e.Identifier)
+			}
+		})
+	}
+}
@@ -3,6 +3,7 @@ package cli
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"github.com/avast/retry-go/v4"
 	"github.com/port-labs/terraform-provider-port-labs/v2/internal/utils"
@@ -38,7 +39,12 @@ func (c *PortClient) ReadTeam(ctx context.Context, teamName string) (*Team, int,
 		Provider:    pt.Team.Provider,
 	}
 
-	team, err := c.enrichTeamFromTeamEntityWithRetry(ctx, portTeam)
+	// Reads don't wait for the entity of a team that was just created, so refreshes aren't blocked by it. The team is
+	// returned without an identifier until its entity exists.
+	team, err := c.enrichTeamFromTeamEntity(ctx, portTeam)
+	if errors.Is(err, MissingTeamEntityError) {
+		team, err = &Team{PortTeam: *portTeam}, nil
+	}
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to enrich team from entity: %w", err)
 	}
@@ -52,10 +58,31 @@ func (c *PortClient) ReadTeam(ctx context.Context, teamName string) (*Team, int,
 	return team, resp.StatusCode(), nil
 }
 
+// ReadTeams reads every team of the organization, with the emails of their users.
+func (c *PortClient) ReadTeams(ctx context.Context) ([]TeamPortBody, error) {
+	var pt PortTeamsBody
+	resp, err := c.Client.R().
+		SetContext(ctx).
+		SetHeader("Accept", "application/json").
+		SetQueryParamsFromValues(url.Values{
+			"fields": []string{"name", "provider", "description", "createdAt", "updatedAt", "users.firstName",
+				"users.status", "users.email"},
+		}).
+		SetResult(&pt).
+		Get(teamsBaseUrl)
+	if err != nil {
+		return nil, err
+	} else if resp.IsError() || !pt.OK {
+		return nil, fmt.Errorf("failed to read teams, got: %s", resp.Body())
+	}
+	return pt.Teams, nil
+}
+
 const teamsBaseUrl = "v1/teams"
 const teamSpecificUrl = teamsBaseUrl + "/{name}"
 
-func (c *PortClient) CreateTeam(ctx context.Context, team *PortTeam) (*Team, error) {
+// CreateTeam creates the team and waits for its entity, see enrichTeamFromTeamEntityWithRetry.
+func (c *PortClient) CreateTeam(ctx context.Context, team *PortTeam, waitUntilDeadline bool) (*Team, error) {
 	resp, err := c.Client.R().
 		SetBody(team).
 		SetContext(ctx).
@@ -73,10 +100,11 @@ func (c *PortClient) CreateTeam(ctx context.Context, team *PortTeam) (*Team, err
 		return nil, fmt.Errorf("failed to create team, got: %s", resp.Body())
 	}
 
-	return c.enrichTeamFromTeamEntityWithRetry(ctx, &pb.Team)
+	return c.enrichTeamFromTeamEntityWithRetry(ctx, &pb.Team, waitUntilDeadline)
 }
 
-func (c *PortClient) UpdateTeam(ctx context.Context, teamName string, team *PortTeam) (*Team, error) {
+// UpdateTeam updates the team and waits for its entity, see enrichTeamFromTeamEntityWithRetry.
+func (c *PortClient) UpdateTeam(ctx context.Context, teamName string, team *PortTeam, waitUntilDeadline bool) (*Team, error) {
 	resp, err := c.Client.R().
 		SetBody(team).
 		SetContext(ctx).
@@ -95,7 +123,7 @@ func (c *PortClient) UpdateTeam(ctx context.Context, teamName string, team *Port
 		return nil, fmt.Errorf("failed to update team, got: %s", resp.Body())
 	}
 
-	return c.enrichTeamFromTeamEntityWithRetry(ctx, &pb.Team)
+	return c.enrichTeamFromTeamEntityWithRetry(ctx, &pb.Team, waitUntilDeadline)
 }
 
 func (c *PortClient) DeleteTeam(ctx context.Context, teamName string) error {
@@ -122,14 +150,27 @@ func (c *PortClient) DeleteTeam(ctx context.Context, teamName string) error {
 
 const MissingTeamEntityError = utils.StringErr("team entity is missing")
 
-func (c *PortClient) enrichTeamFromTeamEntityWithRetry(ctx context.Context, portTeam *PortTeam) (*Team, error) {
+// enrichTeamFromTeamEntityWithRetry waits for the entity of the team, which is created asynchronously, for 10 attempts.
+// When waitUntilDeadline is set, like when the team resource sets a timeout, it waits until the deadline of the context
+// instead.
+func (c *PortClient) enrichTeamFromTeamEntityWithRetry(ctx context.Context, portTeam *PortTeam, waitUntilDeadline bool) (*Team, error) {
+	attempts := []retry.Option{retry.Attempts(1), retry.AttemptsForError(10, MissingTeamEntityError)}
+	if _, ok := ctx.Deadline(); ok && waitUntilDeadline {
+		attempts = []retry.Option{
+			retry.UntilSucceeded(),
+			retry.RetryIf(func(err error) bool { return errors.Is(err, MissingTeamEntityError) }),
+			retry.WrapContextErrorWithLastError(true),
+			retry.MaxDelay(10 * time.Second),
+		}
+	}
 	return retry.DoWithData(
 		func() (*Team, error) { return c.enrichTeamFromTeamEntity(ctx, portTeam) },
-		retry.LastErrorOnly(true),
-		retry.Attempts(1),
-		retry.AttemptsForError(10, MissingTeamEntityError),
-		retry.Delay(time.Second),
-		retry.MaxJitter(time.Second),
+		append(attempts,
+			retry.Context(ctx),
+			retry.LastErrorOnly(true),
+			retry.Delay(time.Second),
+			retry.MaxJitter(time.Second),
+		)...,
 	)
 }
 
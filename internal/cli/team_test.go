@@ -0,0 +1,50 @@
+package cli_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/acctest/fakeclient"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTeamEntityWait(t *testing.T) {
+	ctx := context.Background()
+	server, c := fakeclient.New(t)
+	server.SetFeatureFlags(cli.FeatureFlagUsersAndTeamsV2)
+
+	// The fake doesn't create the entities of teams, so their entity is missing until a test creates it.
+	server.PutObject("team", "platform", map[string]any{"name": "platform", "provider": "port", "users": []any{}})
+
+	t.Run("read doesn't wait", func(t *testing.T) {
+		readCtx, cancel := context.WithTimeout(ctx, time.Minute)
+		defer cancel()
+		start := time.Now()
+		team, _, err := c.ReadTeam(readCtx, "platform")
+		require.NoError(t, err)
+		assert.Less(t, time.Since(start), time.Second)
+		assert.Nil(t, team.Identifier, "the team is read without its entity")
+	})
+
+	t.Run("update waits until the deadline", func(t *testing.T) {
+		updateCtx, cancel := context.WithTimeout(ctx, 2*time.Second)
+		defer cancel()
+		start := time.Now()
+		_, err := c.UpdateTeam(updateCtx, "platform", &cli.PortTeam{Name: "platform", Users: []string{}}, true)
+		assert.ErrorIs(t, err, cli.MissingTeamEntityError)
+		assert.Less(t, time.Since(start), 5*time.Second)
+	})
+
+	t.Run("entity exists", func(t *testing.T) {
+		server.PutObject("entity", "_team/platform_team", map[string]any{
+			"identifier": "platform_team", "title": "platform", "blueprint": "_team", "properties": map[string]any{}, "relations": map[string]any{},
+		})
+		team, _, err := c.ReadTeam(ctx, "platform")
+		require.NoError(t, err)
+		require.NotNil(t, team.Identifier)
+		assert.Equal(t, "platform_team", *team.Identifier)
+	})
+}
@@ -24,6 +24,23 @@ func (c *PortClient) ReadWebhook(ctx context.Context, webhookID string) (*Webhoo
 	return &pb.Webhook, resp.StatusCode(), nil
 }
 
+func (c *PortClient) ReadWebhooks(ctx context.Context) ([]Webhook, error) {
+	pb := &PortBody{}
+	url := "v1/webhooks"
+	resp, err := c.Client.R().
+		SetContext(ctx).
+		SetHeader("Accept", "application/json").
+		SetResult(pb).
+		Get(url)
+	if err != nil {
+		return nil, err
+	}
+	if !pb.OK {
+		return nil, fmt.Errorf("failed to read webhooks, got: %s", resp.Body())
+	}
+	return pb.Webhooks, nil
+}
+
 func (c *PortClient) CreateWebhook(ctx context.Context, webhook *Webhook) (*Webhook, error) {
 	url := "v1/webhooks"
 	resp, err := c.Client.R().
@@ -10,6 +10,7 @@ const (
 	PendingCancellation = "PENDING_CANCELLATION"
 )
 
+// IsTerminalStatus reports whether a migration or a run reached a status it won't leave anymore.
 func IsTerminalStatus(status string) bool {
-	return status == Failure || status == Cancelled || status == Completed
+	return status == Failure || status == Cancelled || status == Completed || status == Success
 }
@@ -0,0 +1,16 @@
+package consts
+
+// Action and workflow runs report these statuses besides the migration ones. Failed runs use the Failure status and
+// finished workflow runs the Completed one.
+const (
+	InProgress = "IN_PROGRESS"
+	Success    = "SUCCESS"
+)
+
+// Integrations report the status of their resync in lowercase.
+const (
+	ResyncRunning   = "running"
+	ResyncCompleted = "completed"
+	ResyncFailed    = "failed"
+	ResyncAborted   = "aborted"
+)
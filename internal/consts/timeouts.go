@@ -0,0 +1,9 @@
+package consts
+
+import "time"
+
+const (
+	// DefaultTimeout is the timeout of the operations of the resources with a `timeouts` block, when the block doesn't
+	// set one. It's the default timeout of the Terraform plugin SDK.
+	DefaultTimeout = 20 * time.Minute
+)
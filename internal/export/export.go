@@ -0,0 +1,306 @@
+// Package export generates Terraform configuration for the resources of an existing Port organization, with `import`
+// blocks that adopt them, so a catalog that was built in the Port UI can be managed with Terraform.
+//
+// The resources are discovered with the Port API client, then imported and read by the provider itself, so the
+// generated configuration matches the resource schemas and `terraform plan` shows no changes after the import.
+package export
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// Config holds the credentials the exporter reads the organization with.
+type Config struct {
+	BaseURL      string
+	ClientID     string
+	ClientSecret string
+}
+
+// Target is a resource to export.
+type Target struct {
+	// Type is the Terraform resource type, e.g. port_blueprint.
+	Type string
+	// Name is the Terraform resource name.
+	Name string
+	// ID is the import ID of the resource.
+	ID string
+}
+
+func (t Target) Address() string {
+	return t.Type + "." + t.Name
+}
+
+// Result summarizes an export.
+type Result struct {
+	// Files are the paths of the written files.
+	Files []string
+	// Exported is the amount of exported resources.
+	Exported int
+	// Skipped holds an error for every resource that couldn't be exported.
+	Skipped []error
+}
+
+type Exporter struct {
+	client   *cli.PortClient
+	provider tfprotov6.ProviderServer
+	schemas  map[string]*tfprotov6.Schema
+}
+
+// New configures provider with config and returns an exporter that discovers the resources with client.
+func New(ctx context.Context, client *cli.PortClient, provider tfprotov6.ProviderServer, config Config) (*Exporter, error) {
+	schemaResp, err := provider.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		return nil, err
+	}
+	if err = diagnosticsError(schemaResp.Diagnostics); err != nil {
+		return nil, fmt.Errorf("failed to read the provider schema: %w", err)
+	}
+
+	providerConfig, err := providerConfigValue(schemaResp.Provider, map[string]string{
+		"base_url":  config.BaseURL,
+		"client_id": config.ClientID,
+		"secret":    config.ClientSecret,
+	})
+	if err != nil {
+		return nil, err
+	}
+	configureResp, err := provider.ConfigureProvider(ctx, &tfprotov6.ConfigureProviderRequest{Config: providerConfig})
+	if err != nil {
+		return nil, err
+	}
+	if err = diagnosticsError(configureResp.Diagnostics); err != nil {
+		return nil, fmt.Errorf("failed to configure the provider: %w", err)
+	}
+
+	return &Exporter{client: client, provider: provider, schemas: schemaResp.ResourceSchemas}, nil
+}
+
+// providerConfigValue builds a provider configuration with the given string attributes, leaving the rest null.
+func providerConfigValue(schema *tfprotov6.Schema, attributes map[string]string) (*tfprotov6.DynamicValue, error) {
+	objectType := schema.ValueType().(tftypes.Object)
+	values := map[string]tftypes.Value{}
+	for name, attributeType := range objectType.AttributeTypes {
+		values[name] = tftypes.NewValue(attributeType, nil)
+		if value := attributes[name]; value != "" {
+			values[name] = tftypes.NewValue(tftypes.String, value)
+		}
+	}
+	config, err := tfprotov6.NewDynamicValue(objectType, tftypes.NewValue(objectType, values))
+	if err != nil {
+		return nil, fmt.Errorf("failed to build the provider configuration: %w", err)
+	}
+	return &config, nil
+}
+
+// Discover lists the resources of the organization. Blueprints and pages that Port manages itself, whose identifiers
+// start with `_` or `$`, are left out.
+func (e *Exporter) Discover(ctx context.Context) ([]Target, error) {
+	names := newNamer()
+	var targets []Target
+	add := func(resourceType, nameHint, id string) {
+		targets = append(targets, Target{Type: resourceType, Name: names.name(resourceType, nameHint), ID: id})
+	}
+
+	blueprints, err := e.client.ReadBlueprints(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, blueprint := range blueprints {
+		if isSystemIdentifier(blueprint.Identifier) {
+			continue
+		}
+		add("port_blueprint", blueprint.Identifier, blueprint.Identifier)
+		add("port_blueprint_permissions", blueprint.Identifier, blueprint.Identifier)
+	}
+
+	actions, err := e.client.ReadActions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, action := range actions {
+		add("port_action", action.Identifier, action.Identifier)
+		add("port_action_permissions", action.Identifier, action.Identifier)
+	}
+
+	scorecards, err := e.client.ReadScorecards(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, scorecard := range scorecards {
+		add("port_scorecard", scorecard.Blueprint+"_"+scorecard.Identifier, scorecard.Blueprint+":"+scorecard.Identifier)
+	}
+
+	pages, err := e.client.ReadPages(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, page := range pages {
+		if isSystemIdentifier(page.Identifier) {
+			continue
+		}
+		add("port_page", page.Identifier, page.Identifier)
+		add("port_page_permissions", page.Identifier, page.Identifier)
+	}
+
+	folders, err := e.client.ReadFolders(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, folder := range folders {
+		add("port_folder", folder.Identifier, folder.Identifier)
+	}
+
+	webhooks, err := e.client.ReadWebhooks(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, webhook := range webhooks {
+		add("port_webhook", webhook.Identifier, webhook.Identifier)
+	}
+
+	integrations, err := e.client.ReadIntegrations(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, integration := range integrations {
+		add("port_integration", integration.InstallationId, integration.InstallationId)
+	}
+
+	teams, err := e.client.ReadTeams(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, team := range teams {
+		// Teams that are synced from an identity provider can't be managed with Terraform.
+		if team.Provider != "" && team.Provider != "port" {
+			continue
+		}
+		add("port_team", team.Name, team.Name)
+	}
+
+	return targets, nil
+}
+
+func isSystemIdentifier(identifier string) bool {
+	return strings.HasPrefix(identifier, "_") || strings.HasPrefix(identifier, "$")
+}
+
+// Export discovers the resources of the organization and writes their configuration to dir, in a file per resource
+// type. Sensitive values aren't written, they're declared as variables in variables.tf instead.
+func (e *Exporter) Export(ctx context.Context, dir string) (*Result, error) {
+	targets, err := e.Discover(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to discover the resources: %w", err)
+	}
+
+	result := &Result{}
+	files := map[string]*hclwrite.File{}
+	variables := &variables{file: hclwrite.NewEmptyFile()}
+	for _, target := range targets {
+		state, err := e.read(ctx, target)
+		if err != nil {
+			result.Skipped = append(result.Skipped, fmt.Errorf("%s (%s): %w", target.Address(), target.ID, err))
+			continue
+		}
+		file, ok := files[target.Type]
+		if !ok {
+			file = hclwrite.NewEmptyFile()
+			files[target.Type] = file
+		}
+		if err = renderResource(file.Body(), target, e.schemas[target.Type].Block, state, variables); err != nil {
+			result.Skipped = append(result.Skipped, fmt.Errorf("%s (%s): %w", target.Address(), target.ID, err))
+			continue
+		}
+		result.Exported++
+	}
+	if variables.count > 0 {
+		files["variables"] = variables.file
+	}
+
+	if err = os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	for _, name := range slices.Sorted(maps.Keys(files)) {
+		path := filepath.Join(dir, name+".tf")
+		if err = os.WriteFile(path, hclwrite.Format(files[name].Bytes()), 0o644); err != nil {
+			return nil, err
+		}
+		result.Files = append(result.Files, path)
+	}
+	return result, nil
+}
+
+// read imports a resource and reads it with the provider, the way `terraform import` does.
+func (e *Exporter) read(ctx context.Context, target Target) (tftypes.Value, error) {
+	schema, ok := e.schemas[target.Type]
+	if !ok {
+		return tftypes.Value{}, fmt.Errorf("the provider has no %s resource", target.Type)
+	}
+	importResp, err := e.provider.ImportResourceState(ctx, &tfprotov6.ImportResourceStateRequest{TypeName: target.Type, ID: target.ID})
+	if err != nil {
+		return tftypes.Value{}, err
+	}
+	if err = diagnosticsError(importResp.Diagnostics); err != nil {
+		return tftypes.Value{}, err
+	}
+	if len(importResp.ImportedResources) != 1 {
+		return tftypes.Value{}, fmt.Errorf("expected a single imported resource, got %d", len(importResp.ImportedResources))
+	}
+	imported := importResp.ImportedResources[0]
+
+	readResp, err := e.provider.ReadResource(ctx, &tfprotov6.ReadResourceRequest{
+		TypeName:     target.Type,
+		CurrentState: imported.State,
+		Private:      imported.Private,
+	})
+	if err != nil {
+		return tftypes.Value{}, err
+	}
+	if err = diagnosticsError(readResp.Diagnostics); err != nil {
+		return tftypes.Value{}, err
+	}
+	if readResp.NewState == nil {
+		return tftypes.Value{}, fmt.Errorf("the resource was not found")
+	}
+	state, err := readResp.NewState.Unmarshal(schema.ValueType())
+	if err != nil {
+		return tftypes.Value{}, err
+	}
+	if state.IsNull() {
+		return tftypes.Value{}, fmt.Errorf("the resource was not found")
+	}
+	return state, nil
+}
+
+func diagnosticsError(diagnostics []*tfprotov6.Diagnostic) error {
+	var messages []string
+	for _, diagnostic := range diagnostics {
+		if diagnostic.Severity == tfprotov6.DiagnosticSeverityError {
+			messages = append(messages, strings.TrimSpace(diagnostic.Summary+": "+diagnostic.Detail))
+		}
+	}
+	if len(messages) == 0 {
+		return nil
+	}
+	return fmt.Errorf("%s", strings.Join(messages, "; "))
+}
+
+// writeImport writes the `import` block of a target.
+func writeImport(body *hclwrite.Body, target Target) {
+	block := body.AppendNewBlock("import", nil).Body()
+	block.SetAttributeTraversal("to", hcl.Traversal{hcl.TraverseRoot{Name: target.Type}, hcl.TraverseAttr{Name: target.Name}})
+	block.SetAttributeValue("id", cty.StringVal(target.ID))
+}
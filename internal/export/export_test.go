@@ -0,0 +1,85 @@
+package export_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/acctest/fakeclient"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/export"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/utils"
+	"github.com/port-labs/terraform-provider-port-labs/v2/provider"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExport(t *testing.T) {
+	ctx := context.Background()
+	server, client := fakeclient.New(t)
+
+	_, err := client.CreateBlueprint(ctx, &cli.Blueprint{
+		Identifier: "service",
+		Title:      "Service",
+		Schema: cli.BlueprintSchema{Properties: map[string]cli.BlueprintProperty{
+			"language": {Type: "string", Title: utils.PtrTo("Language")},
+		}},
+	}, nil)
+	require.NoError(t, err)
+	_, err = client.CreateWebhook(ctx, &cli.Webhook{
+		Identifier: "github",
+		Title:      utils.PtrTo("GitHub"),
+		Enabled:    utils.PtrTo(true),
+		Security:   &cli.Security{Secret: utils.PtrTo("webhook-secret")},
+	})
+	require.NoError(t, err)
+	_, err = client.CreateTeam(ctx, &cli.PortTeam{Name: "platform", Users: []string{}}, false)
+	require.NoError(t, err)
+
+	exporter, err := export.New(ctx, client, providerserver.NewProtocol6(provider.New())(), export.Config{
+		BaseURL:      server.URL,
+		ClientID:     server.ClientID,
+		ClientSecret: server.ClientSecret,
+	})
+	require.NoError(t, err)
+
+	targets, err := exporter.Discover(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []export.Target{
+		{Type: "port_blueprint", Name: "service", ID: "service"},
+		{Type: "port_blueprint_permissions", Name: "service", ID: "service"},
+		{Type: "port_webhook", Name: "github", ID: "github"},
+		{Type: "port_team", Name: "platform", ID: "platform"},
+	}, targets, "system blueprints aren't exported")
+
+	dir := t.TempDir()
+	result, err := exporter.Export(ctx, dir)
+	require.NoError(t, err)
+	assert.Empty(t, result.Skipped)
+	assert.Equal(t, 4, result.Exported)
+
+	blueprint := readFile(t, filepath.Join(dir, "port_blueprint.tf"))
+	assert.Contains(t, blueprint, `import {
+  to = port_blueprint.service
+  id = "service"
+}`)
+	assert.Contains(t, blueprint, `resource "port_blueprint" "service" {`)
+	assert.Regexp(t, `identifier\s+= "service"`, blueprint)
+	assert.Regexp(t, `string_props = {\s+language = {`, blueprint)
+	assert.NotContains(t, blueprint, "created_at", "computed attributes can't be configured")
+
+	webhook := readFile(t, filepath.Join(dir, "port_webhook.tf"))
+	assert.NotContains(t, webhook, "webhook-secret")
+	assert.Contains(t, webhook, "secret = var.port_webhook_github_security_secret", "secrets are replaced by variables")
+	assert.Contains(t, readFile(t, filepath.Join(dir, "variables.tf")), `variable "port_webhook_github_security_secret" {`)
+
+	assert.FileExists(t, filepath.Join(dir, "port_team.tf"))
+}
+
+func readFile(t *testing.T, path string) string {
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	return string(content)
+}
@@ -0,0 +1,272 @@
+package export
+
+import (
+	"fmt"
+	"maps"
+	"math/big"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// renderResource writes the `import` and `resource` blocks of a target, with the configurable attributes of its
+// state. Computed only and write-only attributes are left out, and sensitive values are replaced by variables.
+func renderResource(body *hclwrite.Body, target Target, schema *tfprotov6.SchemaBlock, state tftypes.Value, vars *variables) error {
+	resource := hclwrite.NewEmptyFile()
+	if err := renderBlock(resource.Body(), schema, state, target.Address(), vars); err != nil {
+		return err
+	}
+	writeImport(body, target)
+	body.AppendNewline()
+	block := body.AppendNewBlock("resource", []string{target.Type, target.Name})
+	block.Body().AppendUnstructuredTokens(resource.Body().BuildTokens(nil))
+	body.AppendNewline()
+	return nil
+}
+
+func renderBlock(body *hclwrite.Body, schema *tfprotov6.SchemaBlock, value tftypes.Value, address string, vars *variables) error {
+	var values map[string]tftypes.Value
+	if err := value.As(&values); err != nil {
+		return err
+	}
+	if err := renderAttributes(body, schema.Attributes, values, address, vars); err != nil {
+		return err
+	}
+
+	for _, nested := range schema.BlockTypes {
+		nestedValue := values[nested.TypeName]
+		if nestedValue.IsNull() || !nestedValue.IsKnown() {
+			continue
+		}
+		nestedAddress := address + "." + nested.TypeName
+		switch nested.Nesting {
+		case tfprotov6.SchemaNestedBlockNestingModeSingle, tfprotov6.SchemaNestedBlockNestingModeGroup:
+			if err := renderBlock(body.AppendNewBlock(nested.TypeName, nil).Body(), nested.Block, nestedValue, nestedAddress, vars); err != nil {
+				return err
+			}
+		case tfprotov6.SchemaNestedBlockNestingModeList, tfprotov6.SchemaNestedBlockNestingModeSet:
+			var elements []tftypes.Value
+			if err := nestedValue.As(&elements); err != nil {
+				return err
+			}
+			for _, element := range elements {
+				if err := renderBlock(body.AppendNewBlock(nested.TypeName, nil).Body(), nested.Block, element, nestedAddress, vars); err != nil {
+					return err
+				}
+			}
+		default:
+			return fmt.Errorf("%s: blocks nested as %s aren't supported", nestedAddress, nested.Nesting)
+		}
+	}
+	return nil
+}
+
+// renderAttributes writes the configurable attributes that have a value. Attributes are written in the order of the
+// schema, which sorts them by name.
+func renderAttributes(body *hclwrite.Body, attributes []*tfprotov6.SchemaAttribute, values map[string]tftypes.Value, address string, vars *variables) error {
+	for _, attribute := range attributes {
+		value := values[attribute.Name]
+		if !(attribute.Required || attribute.Optional) || attribute.WriteOnly || value.IsNull() || !value.IsKnown() {
+			continue
+		}
+		attributeAddress := address + "." + attribute.Name
+		if attribute.Sensitive {
+			body.SetAttributeTraversal(attribute.Name, vars.add(attributeAddress, value.Type()))
+			continue
+		}
+		if attribute.NestedType != nil {
+			tokens, err := nestedAttributeTokens(attribute.NestedType, value, attributeAddress, vars)
+			if err != nil {
+				return err
+			}
+			body.SetAttributeRaw(attribute.Name, tokens)
+			continue
+		}
+		converted, err := toCty(value)
+		if err != nil {
+			return fmt.Errorf("%s: %w", attributeAddress, err)
+		}
+		body.SetAttributeValue(attribute.Name, converted)
+	}
+	return nil
+}
+
+// nestedAttributeTokens renders the value of a nested attribute. Its objects are rendered with the same rules as the
+// resource itself, as their computed only attributes can't be configured either.
+func nestedAttributeTokens(nested *tfprotov6.SchemaObject, value tftypes.Value, address string, vars *variables) (hclwrite.Tokens, error) {
+	objectTokens := func(object tftypes.Value, objectAddress string) (hclwrite.Tokens, error) {
+		var values map[string]tftypes.Value
+		if err := object.As(&values); err != nil {
+			return nil, err
+		}
+		objectFile := hclwrite.NewEmptyFile()
+		if err := renderAttributes(objectFile.Body(), nested.Attributes, values, objectAddress, vars); err != nil {
+			return nil, err
+		}
+		tokens := hclwrite.Tokens{token(hclsyntax.TokenOBrace, "{"), token(hclsyntax.TokenNewline, "\n")}
+		tokens = append(tokens, objectFile.Body().BuildTokens(nil)...)
+		return append(tokens, token(hclsyntax.TokenCBrace, "}")), nil
+	}
+
+	switch nested.Nesting {
+	case tfprotov6.SchemaObjectNestingModeSingle:
+		return objectTokens(value, address)
+	case tfprotov6.SchemaObjectNestingModeList, tfprotov6.SchemaObjectNestingModeSet:
+		var elements []tftypes.Value
+		if err := value.As(&elements); err != nil {
+			return nil, err
+		}
+		tokens := hclwrite.Tokens{token(hclsyntax.TokenOBrack, "[")}
+		for i, element := range elements {
+			elementTokens, err := objectTokens(element, fmt.Sprintf("%s[%d]", address, i))
+			if err != nil {
+				return nil, err
+			}
+			tokens = append(tokens, elementTokens...)
+			tokens = append(tokens, token(hclsyntax.TokenComma, ","))
+		}
+		return append(tokens, token(hclsyntax.TokenCBrack, "]")), nil
+	case tfprotov6.SchemaObjectNestingModeMap:
+		var elements map[string]tftypes.Value
+		if err := value.As(&elements); err != nil {
+			return nil, err
+		}
+		tokens := hclwrite.Tokens{token(hclsyntax.TokenOBrace, "{"), token(hclsyntax.TokenNewline, "\n")}
+		for _, key := range slices.Sorted(maps.Keys(elements)) {
+			elementTokens, err := objectTokens(elements[key], fmt.Sprintf("%s[%q]", address, key))
+			if err != nil {
+				return nil, err
+			}
+			if hclsyntax.ValidIdentifier(key) {
+				tokens = append(tokens, token(hclsyntax.TokenIdent, key))
+			} else {
+				tokens = append(tokens, hclwrite.TokensForValue(cty.StringVal(key))...)
+			}
+			tokens = append(tokens, token(hclsyntax.TokenEqual, "="))
+			tokens = append(tokens, elementTokens...)
+			tokens = append(tokens, token(hclsyntax.TokenNewline, "\n"))
+		}
+		return append(tokens, token(hclsyntax.TokenCBrace, "}")), nil
+	}
+	return nil, fmt.Errorf("%s: attributes nested as %s aren't supported", address, nested.Nesting)
+}
+
+func token(tokenType hclsyntax.TokenType, bytes string) *hclwrite.Token {
+	return &hclwrite.Token{Type: tokenType, Bytes: []byte(bytes)}
+}
+
+// toCty converts a Terraform value to its HCL representation. Collections are converted to tuples and objects, so
+// their elements don't have to share a type, e.g. in dynamic values.
+func toCty(value tftypes.Value) (cty.Value, error) {
+	if value.IsNull() {
+		return cty.NullVal(cty.DynamicPseudoType), nil
+	}
+	if !value.IsKnown() {
+		return cty.NilVal, fmt.Errorf("unknown values can't be exported")
+	}
+	valueType := value.Type()
+	switch {
+	case valueType.Is(tftypes.String):
+		var s string
+		err := value.As(&s)
+		return cty.StringVal(s), err
+	case valueType.Is(tftypes.Number):
+		n := new(big.Float)
+		err := value.As(&n)
+		return cty.NumberVal(n), err
+	case valueType.Is(tftypes.Bool):
+		var b bool
+		err := value.As(&b)
+		return cty.BoolVal(b), err
+	case valueType.Is(tftypes.List{}), valueType.Is(tftypes.Set{}), valueType.Is(tftypes.Tuple{}):
+		var elements []tftypes.Value
+		if err := value.As(&elements); err != nil {
+			return cty.NilVal, err
+		}
+		converted := make([]cty.Value, 0, len(elements))
+		for _, element := range elements {
+			c, err := toCty(element)
+			if err != nil {
+				return cty.NilVal, err
+			}
+			converted = append(converted, c)
+		}
+		if len(converted) == 0 {
+			return cty.EmptyTupleVal, nil
+		}
+		return cty.TupleVal(converted), nil
+	case valueType.Is(tftypes.Map{}), valueType.Is(tftypes.Object{}):
+		var elements map[string]tftypes.Value
+		if err := value.As(&elements); err != nil {
+			return cty.NilVal, err
+		}
+		converted := make(map[string]cty.Value, len(elements))
+		for key, element := range elements {
+			c, err := toCty(element)
+			if err != nil {
+				return cty.NilVal, err
+			}
+			converted[key] = c
+		}
+		if len(converted) == 0 {
+			return cty.EmptyObjectVal, nil
+		}
+		return cty.ObjectVal(converted), nil
+	}
+	return cty.NilVal, fmt.Errorf("values of type %s can't be exported", valueType)
+}
+
+// variables declares a variable for every sensitive value, so the exported configuration doesn't hold secrets.
+type variables struct {
+	file  *hclwrite.File
+	names namer
+	count int
+}
+
+func (v *variables) add(address string, valueType tftypes.Type) hcl.Traversal {
+	if v.names == nil {
+		v.names = newNamer()
+	}
+	name := v.names.name("variable", address)
+	block := v.file.Body().AppendNewBlock("variable", []string{name}).Body()
+	block.SetAttributeValue("description", cty.StringVal(fmt.Sprintf("The sensitive value of %s", address)))
+	if valueType.Is(tftypes.String) {
+		block.SetAttributeTraversal("type", hcl.Traversal{hcl.TraverseRoot{Name: "string"}})
+	}
+	block.SetAttributeValue("sensitive", cty.True)
+	v.file.Body().AppendNewline()
+	v.count++
+	return hcl.Traversal{hcl.TraverseRoot{Name: "var"}, hcl.TraverseAttr{Name: name}}
+}
+
+// namer turns identifiers into unique Terraform names, per resource type.
+type namer map[string]map[string]bool
+
+func newNamer() namer {
+	return namer{}
+}
+
+var invalidNameCharacters = regexp.MustCompile(`[^a-zA-Z0-9_-]+`)
+
+func (n namer) name(resourceType, hint string) string {
+	name := strings.Trim(invalidNameCharacters.ReplaceAllString(hint, "_"), "_")
+	if name == "" || !(name[0] == '_' || (name[0] >= 'a' && name[0] <= 'z') || (name[0] >= 'A' && name[0] <= 'Z')) {
+		name = "r_" + name
+	}
+	if n[resourceType] == nil {
+		n[resourceType] = map[string]bool{}
+	}
+	unique := name
+	for i := 2; n[resourceType][unique]; i++ {
+		unique = fmt.Sprintf("%s_%d", name, i)
+	}
+	n[resourceType][unique] = true
+	return unique
+}
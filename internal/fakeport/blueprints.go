@@ -0,0 +1,396 @@
+package fakeport
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+func (s *Server) seedSystemBlueprints() {
+	for id, title := range map[string]string{"_user": "User", "_team": "Team"} {
+		blueprint := map[string]any{
+			"identifier": id,
+			"title":      title,
+			"schema":     map[string]any{"properties": map[string]any{}, "required": []any{}},
+			"relations":  map[string]any{},
+		}
+		withBlueprintDefaults(blueprint)
+		s.meta(blueprint, nil)
+		s.systemBlueprints[id] = deepCopy(blueprint)
+		s.collections["blueprint"].objects[id] = blueprint
+	}
+}
+
+// withBlueprintDefaults fills the fields the API always returns for a blueprint.
+func withBlueprintDefaults(blueprint map[string]any) {
+	schema, _ := blueprint["schema"].(map[string]any)
+	if schema == nil {
+		schema = map[string]any{}
+		blueprint["schema"] = schema
+	}
+	for field, value := range map[string]any{"properties": map[string]any{}, "required": []any{}} {
+		if schema[field] == nil {
+			schema[field] = value
+		}
+	}
+	for _, field := range []string{"relations", "mirrorProperties", "calculationProperties", "aggregationProperties"} {
+		if blueprint[field] == nil {
+			blueprint[field] = map[string]any{}
+		}
+	}
+}
+
+func (s *Server) handleBlueprints(mux *http.ServeMux) {
+	blueprints := s.collections["blueprint"]
+
+	prepare := func(w http.ResponseWriter, obj, previous map[string]any) bool {
+		withBlueprintDefaults(obj)
+		relations, _ := obj["relations"].(map[string]any)
+		for name, relation := range relations {
+			target, _ := relation.(map[string]any)["target"].(string)
+			if _, ok := blueprints.objects[target]; !ok && target != obj["identifier"] {
+				writeError(w, http.StatusNotFound, "not_found", fmt.Sprintf("Relation \"%s\" targets the blueprint \"%s\" which was not found", name, target))
+				return false
+			}
+		}
+		return true
+	}
+
+	mux.HandleFunc("GET /v1/blueprints", func(w http.ResponseWriter, r *http.Request) {
+		writeOK(w, http.StatusOK, "blueprints", blueprints.list(""))
+	})
+	mux.HandleFunc("POST /v1/blueprints", func(w http.ResponseWriter, r *http.Request) {
+		s.createObject(w, r, blueprints, "", prepare)
+	})
+	mux.HandleFunc("GET /v1/blueprints/{identifier}", func(w http.ResponseWriter, r *http.Request) {
+		s.getObject(w, blueprints, r.PathValue("identifier"))
+	})
+	mux.HandleFunc("PUT /v1/blueprints/{identifier}", func(w http.ResponseWriter, r *http.Request) {
+		s.replaceObject(w, r, blueprints, "", r.PathValue("identifier"), prepare)
+	})
+	mux.HandleFunc("DELETE /v1/blueprints/{identifier}", func(w http.ResponseWriter, r *http.Request) {
+		id := r.PathValue("identifier")
+		if _, ok := blueprints.objects[id]; ok {
+			if len(s.collections["entity"].list(id+"/")) > 0 {
+				writeError(w, http.StatusUnprocessableEntity, "has_dependents", fmt.Sprintf("Blueprint \"%s\" has entities, delete them first", id))
+				return
+			}
+			for otherID, other := range blueprints.objects {
+				relations, _ := other["relations"].(map[string]any)
+				for _, relation := range relations {
+					if relation.(map[string]any)["target"] == id && otherID != id {
+						writeError(w, http.StatusUnprocessableEntity, "has_dependents", fmt.Sprintf("Blueprint \"%s\" is the target of relations of the blueprint \"%s\"", id, otherID))
+						return
+					}
+				}
+			}
+		}
+		s.deleteObject(w, blueprints, id)
+	})
+	mux.HandleFunc("DELETE /v1/blueprints/{identifier}/all-entities", func(w http.ResponseWriter, r *http.Request) {
+		id := r.PathValue("identifier")
+		if _, ok := blueprints.objects[id]; !ok {
+			writeNotFound(w, "blueprint", id)
+			return
+		}
+		entities := s.collections["entity"]
+		deleted := 0
+		for key := range entities.objects {
+			if strings.HasPrefix(key, id+"/") {
+				delete(entities.objects, key)
+				deleted++
+			}
+		}
+		deleteBlueprint := r.URL.Query().Get("delete_blueprint") == "true"
+		if deleteBlueprint {
+			delete(blueprints.objects, id)
+		}
+		migration := map[string]any{
+			"id":              s.nextID("migration"),
+			"actor":           s.ClientID,
+			"sourceBlueprint": id,
+			"status":          s.migrationStatus,
+			"deleteBlueprint": deleteBlueprint,
+			"deleteEntities":  true,
+			"successCount":    deleted,
+			"failureCount":    0,
+		}
+		s.meta(migration, nil)
+		s.collections["migration"].objects[migration["id"].(string)] = migration
+		writeOK(w, http.StatusAccepted, "migrationId", migration["id"])
+	})
+	mux.HandleFunc("GET /v1/blueprints/{identifier}/permissions", s.getPermissions("blueprint", defaultBlueprintPermissions))
+	mux.HandleFunc("PATCH /v1/blueprints/{identifier}/permissions", s.patchPermissions("blueprint", defaultBlueprintPermissions))
+
+	// The system blueprint structure route has the same shape as the entity and scorecard routes, so they share a
+	// handler to keep the patterns from conflicting.
+	mux.HandleFunc("/v1/blueprints/{blueprint}/{kind}/{identifier}", func(w http.ResponseWriter, r *http.Request) {
+		blueprint, kind, id := r.PathValue("blueprint"), r.PathValue("kind"), r.PathValue("identifier")
+		switch {
+		case blueprint == "system" && id == "structure" && r.Method == http.MethodGet:
+			structure, ok := s.systemBlueprints[kind]
+			if !ok {
+				writeNotFound(w, "system blueprint", kind)
+				return
+			}
+			writeOK(w, http.StatusOK, "blueprint", deepCopy(structure))
+		case kind == "entities":
+			s.handleEntity(w, r, blueprint, id)
+		case kind == "scorecards":
+			s.handleScorecard(w, r, blueprint, id)
+		default:
+			writeError(w, http.StatusNotFound, "not_found", fmt.Sprintf("Route %s %s was not found", r.Method, r.URL.Path))
+		}
+	})
+}
+
+func defaultBlueprintPermissions() map[string]any {
+	block := func() map[string]any {
+		return map[string]any{"roles": []any{"Admin"}, "users": []any{}, "teams": []any{}, "ownedByTeam": false}
+	}
+	return map[string]any{
+		"entities": map[string]any{
+			"register":         block(),
+			"update":           block(),
+			"unregister":       block(),
+			"updateProperties": map[string]any{},
+			"updateRelations":  map[string]any{},
+		},
+	}
+}
+
+func (s *Server) handleEntities(mux *http.ServeMux) {
+	mux.HandleFunc("POST /v1/blueprints/{blueprint}/entities", func(w http.ResponseWriter, r *http.Request) {
+		blueprintID := r.PathValue("blueprint")
+		blueprint, ok := s.collections["blueprint"].objects[blueprintID]
+		if !ok {
+			writeNotFound(w, "blueprint", blueprintID)
+			return
+		}
+		var entity map[string]any
+		if !decodeBody(w, r, &entity) {
+			return
+		}
+		entities := s.collections["entity"]
+		id, _ := entity["identifier"].(string)
+		if id == "" {
+			id = s.nextID(blueprintID)
+			entity["identifier"] = id
+		}
+		previous, exists := entities.objects[blueprintID+"/"+id]
+		if exists && r.URL.Query().Get("upsert") != "true" {
+			writeError(w, http.StatusConflict, "identifier_taken", fmt.Sprintf("An entity with identifier \"%s\" already exists in blueprint \"%s\"", id, blueprintID))
+			return
+		}
+		if !s.prepareEntity(w, r, blueprint, entity) {
+			return
+		}
+		s.meta(entity, previous)
+		entities.objects[blueprintID+"/"+id] = entity
+		status := http.StatusCreated
+		if exists {
+			status = http.StatusOK
+		}
+		writeOK(w, status, "entity", deepCopy(entity))
+	})
+	// The bulk route upserts every valid entity of the request, and reports the entities it failed to upsert in the
+	// errors of its response, with a multi-status code.
+	mux.HandleFunc("POST /v1/blueprints/{blueprint}/entities/bulk", func(w http.ResponseWriter, r *http.Request) {
+		blueprintID := r.PathValue("blueprint")
+		blueprint, ok := s.collections["blueprint"].objects[blueprintID]
+		if !ok {
+			writeNotFound(w, "blueprint", blueprintID)
+			return
+		}
+		var body struct {
+			Entities []map[string]any `json:"entities"`
+		}
+		if !decodeBody(w, r, &body) {
+			return
+		}
+		if len(body.Entities) == 0 || len(body.Entities) > BulkEntitiesLimit {
+			writeError(w, http.StatusUnprocessableEntity, "invalid_request", fmt.Sprintf("The request must have between 1 and %d entities", BulkEntitiesLimit))
+			return
+		}
+		entities := s.collections["entity"]
+		results, errors := []any{}, []any{}
+		for index, entity := range body.Entities {
+			id, _ := entity["identifier"].(string)
+			if id == "" {
+				id = s.nextID(blueprintID)
+				entity["identifier"] = id
+			}
+			if status, errorName, message := s.validateEntity(r, blueprint, entity); status != 0 {
+				errors = append(errors, map[string]any{"identifier": id, "index": index, "statusCode": status, "error": errorName, "message": message})
+				continue
+			}
+			previous, exists := entities.objects[blueprintID+"/"+id]
+			s.meta(entity, previous)
+			entities.objects[blueprintID+"/"+id] = entity
+			results = append(results, map[string]any{"identifier": id, "index": index, "created": !exists})
+		}
+		status := http.StatusOK
+		if len(errors) != 0 {
+			status = http.StatusMultiStatus
+		}
+		writeJSON(w, status, map[string]any{"ok": true, "entities": results, "errors": errors})
+	})
+}
+
+func (s *Server) handleEntity(w http.ResponseWriter, r *http.Request, blueprintID, id string) {
+	entities := s.collections["entity"]
+	blueprint, ok := s.collections["blueprint"].objects[blueprintID]
+	if !ok {
+		writeNotFound(w, "blueprint", blueprintID)
+		return
+	}
+	switch r.Method {
+	case http.MethodGet:
+		s.getObject(w, entities, blueprintID+"/"+id)
+	case http.MethodPut:
+		s.replaceObject(w, r, entities, blueprintID+"/", id, func(w http.ResponseWriter, obj, previous map[string]any) bool {
+			return s.prepareEntity(w, r, blueprint, obj)
+		})
+	case http.MethodPatch:
+		s.patchObject(w, r, entities, blueprintID+"/"+id, func(w http.ResponseWriter, obj, previous map[string]any) bool {
+			return s.prepareEntity(w, r, blueprint, obj)
+		})
+	case http.MethodDelete:
+		s.deleteObject(w, entities, blueprintID+"/"+id)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", fmt.Sprintf("Method %s is not allowed", r.Method))
+	}
+}
+
+// prepareEntity validates the properties and relations of an entity against its blueprint, and fills the fields the
+// API returns for every entity.
+func (s *Server) prepareEntity(w http.ResponseWriter, r *http.Request, blueprint, entity map[string]any) bool {
+	if status, errorName, message := s.validateEntity(r, blueprint, entity); status != 0 {
+		writeError(w, status, errorName, message)
+		return false
+	}
+	return true
+}
+
+// validateEntity is prepareEntity for the bulk route, which reports the error of every entity in its response instead
+// of failing the request. It returns a zero status when the entity is valid.
+func (s *Server) validateEntity(r *http.Request, blueprint, entity map[string]any) (int, string, string) {
+	blueprintID := blueprint["identifier"].(string)
+	entity["blueprint"] = blueprintID
+	for _, field := range []string{"properties", "relations"} {
+		if entity[field] == nil {
+			entity[field] = map[string]any{}
+		}
+	}
+	if entity["title"] == nil {
+		entity["title"] = entity["identifier"]
+	}
+
+	schema := blueprint["schema"].(map[string]any)
+	definitions, _ := schema["properties"].(map[string]any)
+	properties, _ := entity["properties"].(map[string]any)
+	for name, value := range properties {
+		definition, ok := definitions[name].(map[string]any)
+		if !ok {
+			return http.StatusUnprocessableEntity, "invalid_request", fmt.Sprintf("Property \"%s\" is not defined in blueprint \"%s\"", name, blueprintID)
+		}
+		if value != nil && !matchesType(definition["type"], value) {
+			return http.StatusUnprocessableEntity, "invalid_request", fmt.Sprintf("Property \"%s\" must be of type %v", name, definition["type"])
+		}
+	}
+	required, _ := schema["required"].([]any)
+	for _, name := range required {
+		if properties[name.(string)] == nil {
+			return http.StatusUnprocessableEntity, "invalid_request", fmt.Sprintf("Property \"%s\" is required", name)
+		}
+	}
+
+	entities := s.collections["entity"]
+	createMissing := r.URL.Query().Get("create_missing_related_entities") == "true"
+	relationDefinitions, _ := blueprint["relations"].(map[string]any)
+	relations, _ := entity["relations"].(map[string]any)
+	for name, value := range relations {
+		definition, ok := relationDefinitions[name].(map[string]any)
+		if !ok {
+			return http.StatusUnprocessableEntity, "invalid_request", fmt.Sprintf("Relation \"%s\" is not defined in blueprint \"%s\"", name, blueprintID)
+		}
+		target, _ := definition["target"].(string)
+		var targets []any
+		switch v := value.(type) {
+		case string:
+			targets = []any{v}
+		case []any:
+			targets = v
+		}
+		for _, related := range targets {
+			relatedID, _ := related.(string)
+			if _, ok := entities.objects[target+"/"+relatedID]; ok {
+				continue
+			}
+			if !createMissing {
+				return http.StatusNotFound, "not_found", fmt.Sprintf("Related entity \"%s\" of blueprint \"%s\" was not found", relatedID, target)
+			}
+			missing := map[string]any{
+				"identifier": relatedID,
+				"title":      relatedID,
+				"blueprint":  target,
+				"properties": map[string]any{},
+				"relations":  map[string]any{},
+			}
+			s.meta(missing, nil)
+			entities.objects[target+"/"+relatedID] = missing
+		}
+	}
+	return 0, "", ""
+}
+
+func matchesType(propertyType any, value any) bool {
+	switch value.(type) {
+	case string:
+		return propertyType == "string"
+	case float64:
+		return propertyType == "number"
+	case bool:
+		return propertyType == "boolean"
+	case []any:
+		return propertyType == "array"
+	case map[string]any:
+		return propertyType == "object"
+	}
+	return false
+}
+
+func (s *Server) handleScorecards(mux *http.ServeMux) {
+	mux.HandleFunc("GET /v1/scorecards", func(w http.ResponseWriter, r *http.Request) {
+		writeOK(w, http.StatusOK, "scorecards", s.collections["scorecard"].list(""))
+	})
+	mux.HandleFunc("POST /v1/blueprints/{blueprint}/scorecards", func(w http.ResponseWriter, r *http.Request) {
+		blueprintID := r.PathValue("blueprint")
+		if _, ok := s.collections["blueprint"].objects[blueprintID]; !ok {
+			writeNotFound(w, "blueprint", blueprintID)
+			return
+		}
+		s.createObject(w, r, s.collections["scorecard"], blueprintID+"/", prepareScorecard(blueprintID))
+	})
+}
+
+func (s *Server) handleScorecard(w http.ResponseWriter, r *http.Request, blueprintID, id string) {
+	scorecards := s.collections["scorecard"]
+	switch r.Method {
+	case http.MethodGet:
+		s.getObject(w, scorecards, blueprintID+"/"+id)
+	case http.MethodPut:
+		s.replaceObject(w, r, scorecards, blueprintID+"/", id, prepareScorecard(blueprintID))
+	case http.MethodDelete:
+		s.deleteObject(w, scorecards, blueprintID+"/"+id)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", fmt.Sprintf("Method %s is not allowed", r.Method))
+	}
+}
+
+func prepareScorecard(blueprintID string) prepareFunc {
+	return func(w http.ResponseWriter, obj, previous map[string]any) bool {
+		obj["blueprint"] = blueprintID
+		return true
+	}
+}
@@ -0,0 +1,145 @@
+package fakeport
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+)
+
+// collection stores the objects of a kind, keyed by their identifier. Objects that belong to a blueprint, such as
+// entities and scorecards, are keyed by "<blueprint>/<identifier>".
+type collection struct {
+	name        string
+	responseKey string
+	idField     string
+	// hiddenFields are stored but never returned, like secret values.
+	hiddenFields []string
+	objects      map[string]map[string]any
+	// onRead is called with every object read by its key, before it's returned. See Server.OnRead.
+	onRead func(obj map[string]any)
+}
+
+// response returns a copy of obj without its hidden fields.
+func (c *collection) response(obj map[string]any) map[string]any {
+	copied := deepCopy(obj)
+	for _, field := range c.hiddenFields {
+		delete(copied, field)
+	}
+	return copied
+}
+
+// list returns the objects whose key starts with prefix, sorted by key.
+func (c *collection) list(prefix string) []map[string]any {
+	keys := make([]string, 0, len(c.objects))
+	for k := range c.objects {
+		if strings.HasPrefix(k, prefix) {
+			keys = append(keys, k)
+		}
+	}
+	slices.Sort(keys)
+	objects := make([]map[string]any, 0, len(keys))
+	for _, k := range keys {
+		objects = append(objects, c.response(c.objects[k]))
+	}
+	return objects
+}
+
+// prepareFunc validates and completes an object before it's stored. previous is nil when the object is created. It
+// writes an error response and returns false when the object is invalid.
+type prepareFunc func(w http.ResponseWriter, obj, previous map[string]any) bool
+
+func (s *Server) createObject(w http.ResponseWriter, r *http.Request, c *collection, scope string, prepare prepareFunc) {
+	var obj map[string]any
+	if !decodeBody(w, r, &obj) {
+		return
+	}
+	id, _ := obj[c.idField].(string)
+	if id == "" {
+		writeError(w, http.StatusUnprocessableEntity, "invalid_request", fmt.Sprintf("%s is required", c.idField))
+		return
+	}
+	if _, ok := c.objects[scope+id]; ok {
+		writeError(w, http.StatusConflict, "identifier_taken", fmt.Sprintf("A %s with identifier \"%s\" already exists", c.name, id))
+		return
+	}
+	s.meta(obj, nil)
+	if prepare != nil && !prepare(w, obj, nil) {
+		return
+	}
+	c.objects[scope+id] = obj
+	writeOK(w, http.StatusCreated, c.responseKey, c.response(obj))
+}
+
+func (s *Server) getObject(w http.ResponseWriter, c *collection, key string) {
+	obj, ok := c.objects[key]
+	if !ok {
+		writeNotFound(w, c.name, key)
+		return
+	}
+	if c.onRead != nil {
+		c.onRead(obj)
+	}
+	writeOK(w, http.StatusOK, c.responseKey, c.response(obj))
+}
+
+// replaceObject replaces a whole object, like the PUT endpoints of the API. The object may be renamed by sending a
+// different identifier than the one in the path.
+func (s *Server) replaceObject(w http.ResponseWriter, r *http.Request, c *collection, scope, id string, prepare prepareFunc) {
+	previous, ok := c.objects[scope+id]
+	if !ok {
+		writeNotFound(w, c.name, id)
+		return
+	}
+	var obj map[string]any
+	if !decodeBody(w, r, &obj) {
+		return
+	}
+	newID, _ := obj[c.idField].(string)
+	if newID == "" {
+		newID = id
+		obj[c.idField] = id
+	}
+	if _, taken := c.objects[scope+newID]; taken && newID != id {
+		writeError(w, http.StatusConflict, "identifier_taken", fmt.Sprintf("A %s with identifier \"%s\" already exists", c.name, newID))
+		return
+	}
+	s.meta(obj, previous)
+	if prepare != nil && !prepare(w, obj, previous) {
+		return
+	}
+	delete(c.objects, scope+id)
+	c.objects[scope+newID] = obj
+	writeOK(w, http.StatusOK, c.responseKey, c.response(obj))
+}
+
+// patchObject merges the request body into an object, like the PATCH endpoints of the API.
+func (s *Server) patchObject(w http.ResponseWriter, r *http.Request, c *collection, key string, prepare prepareFunc) {
+	previous, ok := c.objects[key]
+	if !ok {
+		writeNotFound(w, c.name, key)
+		return
+	}
+	var patch map[string]any
+	if !decodeBody(w, r, &patch) {
+		return
+	}
+	obj := deepCopy(previous)
+	deepMerge(obj, patch)
+	obj[c.idField] = previous[c.idField]
+	s.meta(obj, previous)
+	if prepare != nil && !prepare(w, obj, previous) {
+		return
+	}
+	c.objects[key] = obj
+	writeOK(w, http.StatusOK, c.responseKey, c.response(obj))
+}
+
+func (s *Server) deleteObject(w http.ResponseWriter, c *collection, key string) {
+	if _, ok := c.objects[key]; !ok {
+		writeNotFound(w, c.name, key)
+		return
+	}
+	delete(c.objects, key)
+	writeOK(w, http.StatusOK, "", nil)
+}
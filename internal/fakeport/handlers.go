@@ -0,0 +1,332 @@
+package fakeport
+
+import (
+	"fmt"
+	"maps"
+	"net/http"
+	"slices"
+	"time"
+)
+
+// registerCRUD registers the routes of a collection that's listed with GET and created with POST on path, and read,
+// replaced and deleted on path/{identifier}. listKey is the field of the list response that holds the objects.
+func (s *Server) registerCRUD(mux *http.ServeMux, path, listKey string, c *collection, prepare prepareFunc) {
+	mux.HandleFunc("GET "+path, func(w http.ResponseWriter, r *http.Request) {
+		writeOK(w, http.StatusOK, listKey, c.list(""))
+	})
+	mux.HandleFunc("POST "+path, func(w http.ResponseWriter, r *http.Request) {
+		s.createObject(w, r, c, "", prepare)
+	})
+	mux.HandleFunc("GET "+path+"/{identifier}", func(w http.ResponseWriter, r *http.Request) {
+		s.getObject(w, c, r.PathValue("identifier"))
+	})
+	mux.HandleFunc("PUT "+path+"/{identifier}", func(w http.ResponseWriter, r *http.Request) {
+		s.replaceObject(w, r, c, "", r.PathValue("identifier"), prepare)
+	})
+	mux.HandleFunc("DELETE "+path+"/{identifier}", func(w http.ResponseWriter, r *http.Request) {
+		s.deleteObject(w, c, r.PathValue("identifier"))
+	})
+}
+
+// getPermissions serves the permissions of an object, which start with the defaults until they're patched.
+func (s *Server) getPermissions(kind string, defaults func() map[string]any) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id := r.PathValue("identifier")
+		if _, ok := s.collections[kind].objects[id]; !ok {
+			writeNotFound(w, kind, id)
+			return
+		}
+		permissions, ok := s.permissions[kind+"/"+id]
+		if !ok {
+			permissions = defaults()
+		}
+		writeOK(w, http.StatusOK, "permissions", deepCopy(permissions))
+	}
+}
+
+func (s *Server) patchPermissions(kind string, defaults func() map[string]any) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id := r.PathValue("identifier")
+		if _, ok := s.collections[kind].objects[id]; !ok {
+			writeNotFound(w, kind, id)
+			return
+		}
+		var patch map[string]any
+		if !decodeBody(w, r, &patch) {
+			return
+		}
+		permissions, ok := s.permissions[kind+"/"+id]
+		if !ok {
+			permissions = defaults()
+		}
+		deepMerge(permissions, patch)
+		s.permissions[kind+"/"+id] = permissions
+		writeOK(w, http.StatusOK, "permissions", deepCopy(permissions))
+	}
+}
+
+func (s *Server) handleActions(mux *http.ServeMux) {
+	actions := s.collections["action"]
+	s.registerCRUD(mux, "/v1/actions", "actions", actions, func(w http.ResponseWriter, obj, previous map[string]any) bool {
+		obj["id"] = s.nextID("action")
+		if previous != nil {
+			obj["id"] = previous["id"]
+		}
+		return true
+	})
+	// GET /v1/actions/runs/{run_id} overlaps the permissions route, so it's served by the same pattern.
+	getActionPermissions := s.getPermissions("action", defaultActionPermissions)
+	mux.HandleFunc("GET /v1/actions/{identifier}/{kind}", func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.PathValue("identifier") == "runs":
+			s.getObject(w, s.collections["run"], r.PathValue("kind"))
+		case r.PathValue("kind") == "permissions":
+			getActionPermissions(w, r)
+		default:
+			writeError(w, http.StatusNotFound, "not_found", fmt.Sprintf("Route %s %s was not found", r.Method, r.URL.Path))
+		}
+	})
+	mux.HandleFunc("PATCH /v1/actions/{identifier}/permissions", s.patchPermissions("action", defaultActionPermissions))
+}
+
+func defaultActionPermissions() map[string]any {
+	return map[string]any{
+		"execute": map[string]any{"roles": []any{"Admin"}, "users": []any{}, "teams": []any{}, "ownedByTeam": false},
+		"approve": map[string]any{"roles": []any{}, "users": []any{}, "teams": []any{}},
+	}
+}
+
+func (s *Server) handleTeams(mux *http.ServeMux) {
+	teams := s.collections["team"]
+	prepare := func(w http.ResponseWriter, obj, previous map[string]any) bool {
+		obj["provider"] = "port"
+		if obj["users"] == nil {
+			obj["users"] = []any{}
+		}
+		return true
+	}
+	mux.HandleFunc("POST /v1/teams", func(w http.ResponseWriter, r *http.Request) {
+		s.createObject(w, r, teams, "", prepare)
+	})
+	mux.HandleFunc("GET /v1/teams", func(w http.ResponseWriter, r *http.Request) {
+		list := teams.list("")
+		for _, team := range list {
+			withTeamUsers(team)
+		}
+		writeOK(w, http.StatusOK, "teams", list)
+	})
+	mux.HandleFunc("GET /v1/teams/{name}", func(w http.ResponseWriter, r *http.Request) {
+		name := r.PathValue("name")
+		team, ok := teams.objects[name]
+		if !ok {
+			writeNotFound(w, "team", name)
+			return
+		}
+		team = deepCopy(team)
+		withTeamUsers(team)
+		writeOK(w, http.StatusOK, "team", team)
+	})
+	mux.HandleFunc("PUT /v1/teams/{name}", func(w http.ResponseWriter, r *http.Request) {
+		s.replaceObject(w, r, teams, "", r.PathValue("name"), prepare)
+	})
+	mux.HandleFunc("DELETE /v1/teams/{name}", func(w http.ResponseWriter, r *http.Request) {
+		s.deleteObject(w, teams, r.PathValue("name"))
+	})
+}
+
+// withTeamUsers replaces the emails of the users of a team with the users themselves. Teams are written with the
+// emails of their users, but read with the users.
+func withTeamUsers(team map[string]any) {
+	users := []any{}
+	for _, email := range team["users"].([]any) {
+		users = append(users, map[string]any{"email": email, "status": "Active"})
+	}
+	team["users"] = users
+}
+
+func (s *Server) handlePages(mux *http.ServeMux) {
+	s.registerCRUD(mux, "/v1/pages", "pages", s.collections["page"], nil)
+	mux.HandleFunc("GET /v1/pages/{identifier}/permissions", s.getPermissions("page", defaultPagePermissions))
+	mux.HandleFunc("PATCH /v1/pages/{identifier}/permissions", s.patchPermissions("page", defaultPagePermissions))
+}
+
+func defaultPagePermissions() map[string]any {
+	return map[string]any{
+		"read": map[string]any{"roles": []any{"Admin", "Member"}, "users": []any{}, "teams": []any{}},
+	}
+}
+
+func (s *Server) handleFolders(mux *http.ServeMux) {
+	folders := s.collections["folder"]
+	prepare := func(w http.ResponseWriter, obj, previous map[string]any) bool {
+		if parent, ok := obj["parent"].(string); ok && parent != "" {
+			if _, ok := folders.objects[parent]; !ok {
+				writeNotFound(w, "folder", parent)
+				return false
+			}
+		}
+		return true
+	}
+
+	// The sidebar lists the folders and the pages together.
+	mux.HandleFunc("GET /v1/sidebars/{sidebar}", func(w http.ResponseWriter, r *http.Request) {
+		sidebar := r.PathValue("sidebar")
+		items := []map[string]any{}
+		for _, folder := range folders.list("") {
+			if folder["sidebar"] == sidebar {
+				folder["sidebarType"] = "folder"
+				items = append(items, folder)
+			}
+		}
+		for _, page := range s.collections["page"].list("") {
+			items = append(items, map[string]any{
+				"identifier":  page["identifier"],
+				"title":       page["title"],
+				"sidebar":     sidebar,
+				"parent":      page["parent"],
+				"after":       page["after"],
+				"sidebarType": "page",
+			})
+		}
+		writeOK(w, http.StatusOK, "sidebar", map[string]any{"identifier": sidebar, "items": items})
+	})
+	mux.HandleFunc("POST /v1/sidebars/{sidebar}/folders", func(w http.ResponseWriter, r *http.Request) {
+		sidebar := r.PathValue("sidebar")
+		s.createObject(w, r, folders, "", func(w http.ResponseWriter, obj, previous map[string]any) bool {
+			obj["sidebar"] = sidebar
+			return prepare(w, obj, previous)
+		})
+	})
+	mux.HandleFunc("PATCH /v1/sidebars/{sidebar}/folders/{identifier}", func(w http.ResponseWriter, r *http.Request) {
+		s.patchObject(w, r, folders, r.PathValue("identifier"), prepare)
+	})
+	mux.HandleFunc("DELETE /v1/sidebars/{sidebar}/folders/{identifier}", func(w http.ResponseWriter, r *http.Request) {
+		s.deleteObject(w, folders, r.PathValue("identifier"))
+	})
+}
+
+func (s *Server) handleWebhooks(mux *http.ServeMux) {
+	s.registerCRUD(mux, "/v1/webhooks", "integrations", s.collections["webhook"], func(w http.ResponseWriter, obj, previous map[string]any) bool {
+		if previous != nil {
+			obj["webhookKey"], obj["url"] = previous["webhookKey"], previous["url"]
+			return true
+		}
+		key := s.nextID("webhook")
+		obj["webhookKey"] = key
+		obj["url"] = fmt.Sprintf("%s/v1/webhooks/incoming/%s", s.URL, key)
+		return true
+	})
+}
+
+func (s *Server) handleIntegrations(mux *http.ServeMux) {
+	integrations := s.collections["integration"]
+	mux.HandleFunc("GET /v1/integration", func(w http.ResponseWriter, r *http.Request) {
+		writeOK(w, http.StatusOK, "integrations", integrations.list(""))
+	})
+	mux.HandleFunc("POST /v1/integration", func(w http.ResponseWriter, r *http.Request) {
+		s.createObject(w, r, integrations, "", nil)
+	})
+	mux.HandleFunc("GET /v1/integration/{identifier}", func(w http.ResponseWriter, r *http.Request) {
+		s.getObject(w, integrations, r.PathValue("identifier"))
+	})
+	mux.HandleFunc("PATCH /v1/integration/{identifier}", func(w http.ResponseWriter, r *http.Request) {
+		s.patchObject(w, r, integrations, r.PathValue("identifier"), nil)
+	})
+	mux.HandleFunc("DELETE /v1/integration/{identifier}", func(w http.ResponseWriter, r *http.Request) {
+		s.deleteObject(w, integrations, r.PathValue("identifier"))
+	})
+	// Resyncs finish right away, with the state SetResyncState controls.
+	mux.HandleFunc("POST /v1/integration/{identifier}/resync", func(w http.ResponseWriter, r *http.Request) {
+		obj, ok := integrations.objects[r.PathValue("identifier")]
+		if !ok {
+			writeNotFound(w, integrations.name, r.PathValue("identifier"))
+			return
+		}
+		state := maps.Clone(s.resyncState)
+		now := time.Now().UTC().Format(time.RFC3339Nano)
+		state["lastResyncStart"], state["lastResyncEnd"] = now, now
+		obj["resyncState"] = state
+		writeOK(w, http.StatusOK, integrations.responseKey, integrations.response(obj))
+	})
+}
+
+// SetResyncState sets the state integrations report once they resynced, e.g. their status and metrics. Resyncs
+// complete by default.
+func (s *Server) SetResyncState(state map[string]any) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.resyncState = state
+}
+
+// SetMigrationStatus sets the status that the migrations deleting the entities of a blueprint are created with from
+// now on. They are created COMPLETED by default, as the fake deletes the entities right away.
+func (s *Server) SetMigrationStatus(status string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.migrationStatus = status
+}
+
+func (s *Server) handleMigrations(mux *http.ServeMux) {
+	mux.HandleFunc("GET /v1/migrations/{identifier}", func(w http.ResponseWriter, r *http.Request) {
+		s.getObject(w, s.collections["migration"], r.PathValue("identifier"))
+	})
+}
+
+func (s *Server) handleOrganization(mux *http.ServeMux) {
+	mux.HandleFunc("GET /v1/organization", func(w http.ResponseWriter, r *http.Request) {
+		writeOK(w, http.StatusOK, "organization", deepCopy(s.organization))
+	})
+	mux.HandleFunc("PATCH /v1/organization", func(w http.ResponseWriter, r *http.Request) {
+		var patch map[string]any
+		if !decodeBody(w, r, &patch) {
+			return
+		}
+		deepMerge(s.organization, patch)
+		writeOK(w, http.StatusOK, "organization", deepCopy(s.organization))
+	})
+
+	secrets := s.collections["secret"]
+	mux.HandleFunc("POST /v1/organization/secrets", func(w http.ResponseWriter, r *http.Request) {
+		s.createObject(w, r, secrets, "", func(w http.ResponseWriter, obj, previous map[string]any) bool {
+			if value, _ := obj["secretValue"].(string); value == "" {
+				writeError(w, http.StatusUnprocessableEntity, "invalid_request", "secretValue is required")
+				return false
+			}
+			return true
+		})
+	})
+	mux.HandleFunc("GET /v1/organization/secrets/{identifier}", func(w http.ResponseWriter, r *http.Request) {
+		s.getObject(w, secrets, r.PathValue("identifier"))
+	})
+	mux.HandleFunc("PATCH /v1/organization/secrets/{identifier}", func(w http.ResponseWriter, r *http.Request) {
+		s.patchObject(w, r, secrets, r.PathValue("identifier"), nil)
+	})
+	mux.HandleFunc("DELETE /v1/organization/secrets/{identifier}", func(w http.ResponseWriter, r *http.Request) {
+		s.deleteObject(w, secrets, r.PathValue("identifier"))
+	})
+}
+
+// SetFeatureFlags replaces the feature flags of the organization.
+func (s *Server) SetFeatureFlags(flags ...string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	featureFlags := []any{}
+	for _, flag := range slices.Sorted(slices.Values(flags)) {
+		featureFlags = append(featureFlags, flag)
+	}
+	s.organization["featureFlags"] = featureFlags
+}
+
+func (s *Server) handleWorkflows(mux *http.ServeMux) {
+	s.registerCRUD(mux, "/v1/workflows", "workflows", s.collections["workflow"], nil)
+}
+
+func (s *Server) handlePermissions(mux *http.ServeMux) {
+	mux.HandleFunc("POST /v1/apps/{app_id}/permissions", func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]any
+		if !decodeBody(w, r, &body) {
+			return
+		}
+		writeOK(w, http.StatusOK, "", nil)
+	})
+}
@@ -0,0 +1,70 @@
+package fakeport
+
+import (
+	"net/http"
+)
+
+// handleRuns serves the runs of self-service actions and workflows. Runs don't invoke anything, they keep the status
+// they were created with, which SetRunStatus controls.
+func (s *Server) handleRuns(mux *http.ServeMux) {
+	mux.HandleFunc("POST /v1/actions/{identifier}/runs", func(w http.ResponseWriter, r *http.Request) {
+		actionID := r.PathValue("identifier")
+		if _, ok := s.collections["action"].objects[actionID]; !ok {
+			writeNotFound(w, "action", actionID)
+			return
+		}
+		var body map[string]any
+		if !decodeBody(w, r, &body) {
+			return
+		}
+		run := map[string]any{
+			"id":         s.nextID("r"),
+			"status":     s.runStatus,
+			"action":     map[string]any{"identifier": actionID},
+			"properties": body["properties"],
+		}
+		if entity, ok := body["entity"]; ok {
+			run["entity"] = map[string]any{"identifier": entity}
+		}
+		s.meta(run, nil)
+		runs := s.collections["run"]
+		runs.objects[run["id"].(string)] = run
+		writeOK(w, http.StatusAccepted, runs.responseKey, runs.response(run))
+	})
+
+	workflowRuns := s.collections["workflowRun"]
+	mux.HandleFunc("POST /v1/workflows/{identifier}/runs", func(w http.ResponseWriter, r *http.Request) {
+		workflowID := r.PathValue("identifier")
+		if _, ok := s.collections["workflow"].objects[workflowID]; !ok {
+			writeNotFound(w, "workflow", workflowID)
+			return
+		}
+		var body map[string]any
+		if !decodeBody(w, r, &body) {
+			return
+		}
+		run := map[string]any{
+			"identifier": s.nextID("wfr"),
+			"status":     s.runStatus,
+			"workflow":   workflowID,
+			"inputs":     body["inputs"],
+		}
+		if entity, ok := body["entity"]; ok {
+			run["entity"] = entity
+		}
+		s.meta(run, nil)
+		workflowRuns.objects[run["identifier"].(string)] = run
+		writeOK(w, http.StatusAccepted, workflowRuns.responseKey, workflowRuns.response(run))
+	})
+	mux.HandleFunc("GET /v1/workflows/runs/{identifier}", func(w http.ResponseWriter, r *http.Request) {
+		s.getObject(w, workflowRuns, r.PathValue("identifier"))
+	})
+}
+
+// SetRunStatus sets the status that action and workflow runs are created with from now on. Runs are created
+// IN_PROGRESS by default.
+func (s *Server) SetRunStatus(status string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.runStatus = status
+}
@@ -0,0 +1,174 @@
+package fakeport
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// defaultSearchLimit is the page size of the search API when the request doesn't set one.
+const defaultSearchLimit = 1000
+
+func (s *Server) handleSearch(mux *http.ServeMux) {
+	mux.HandleFunc("POST /v1/entities/search", func(w http.ResponseWriter, r *http.Request) {
+		var query map[string]any
+		if !decodeBody(w, r, &query) {
+			return
+		}
+		limit := defaultSearchLimit
+		if l, ok := query["limit"].(float64); ok && l > 0 {
+			limit = int(l)
+		}
+		from := 0
+		if cursor, ok := query["from"].(string); ok && cursor != "" {
+			offset, err := strconv.Atoi(cursor)
+			if err != nil || offset < 0 {
+				writeError(w, http.StatusUnprocessableEntity, "invalid_request", fmt.Sprintf("The cursor \"%s\" is invalid", cursor))
+				return
+			}
+			from = offset
+		}
+
+		matches := []map[string]any{}
+		matchingBlueprints := []string{}
+		for _, entity := range s.collections["entity"].list("") {
+			matched, err := evaluateRule(query, entity)
+			if err != nil {
+				writeError(w, http.StatusUnprocessableEntity, "invalid_request", err.Error())
+				return
+			}
+			if !matched {
+				continue
+			}
+			matches = append(matches, entity)
+			if blueprint := entity["blueprint"].(string); !slices.Contains(matchingBlueprints, blueprint) {
+				matchingBlueprints = append(matchingBlueprints, blueprint)
+			}
+		}
+
+		page := matches[min(from, len(matches)):min(from+limit, len(matches))]
+		var include []string
+		if param := r.URL.Query().Get("include"); param != "" {
+			include = strings.Split(param, ",")
+		}
+		for i, entity := range page {
+			page[i] = includeFields(entity, include)
+		}
+		body := map[string]any{"ok": true, "entities": page, "matchingBlueprints": matchingBlueprints}
+		if from+limit < len(matches) {
+			body["next"] = strconv.Itoa(from + limit)
+		}
+		writeJSON(w, http.StatusOK, body)
+	})
+}
+
+// evaluateRule reports whether an entity matches a search rule. A rule is either a combinator of nested rules or a
+// comparison of a property with a value.
+func evaluateRule(rule map[string]any, entity map[string]any) (bool, error) {
+	if rules, ok := rule["rules"].([]any); ok {
+		combinator, _ := rule["combinator"].(string)
+		if combinator == "" {
+			combinator = "and"
+		}
+		if combinator != "and" && combinator != "or" {
+			return false, fmt.Errorf("the combinator \"%s\" is not supported", combinator)
+		}
+		for _, nested := range rules {
+			nestedRule, ok := nested.(map[string]any)
+			if !ok {
+				return false, fmt.Errorf("rules must be objects")
+			}
+			matched, err := evaluateRule(nestedRule, entity)
+			if err != nil {
+				return false, err
+			}
+			if combinator == "or" && matched {
+				return true, nil
+			}
+			if combinator == "and" && !matched {
+				return false, nil
+			}
+		}
+		return combinator == "and", nil
+	}
+
+	property, ok := rule["property"].(string)
+	if !ok {
+		return false, fmt.Errorf("rules must have a property or nested rules")
+	}
+	actual := propertyValue(entity, property)
+	expected := rule["value"]
+	switch operator, _ := rule["operator"].(string); operator {
+	case "=":
+		return reflect.DeepEqual(actual, expected), nil
+	case "!=":
+		return !reflect.DeepEqual(actual, expected), nil
+	case "in", "notIn":
+		values, ok := expected.([]any)
+		if !ok {
+			return false, fmt.Errorf("the value of the \"%s\" operator must be an array", operator)
+		}
+		found := slices.ContainsFunc(values, func(v any) bool { return reflect.DeepEqual(actual, v) })
+		return found == (operator == "in"), nil
+	case "contains", "beginsWith", "endsWith":
+		actualString, _ := actual.(string)
+		expectedString, ok := expected.(string)
+		if !ok {
+			return false, fmt.Errorf("the value of the \"%s\" operator must be a string", operator)
+		}
+		switch operator {
+		case "contains":
+			return strings.Contains(actualString, expectedString), nil
+		case "beginsWith":
+			return strings.HasPrefix(actualString, expectedString), nil
+		default:
+			return strings.HasSuffix(actualString, expectedString), nil
+		}
+	case "isEmpty", "isNotEmpty":
+		empty := actual == nil || actual == "" || reflect.DeepEqual(actual, []any{})
+		return empty == (operator == "isEmpty"), nil
+	default:
+		return false, fmt.Errorf("the operator \"%s\" is not supported", operator)
+	}
+}
+
+// propertyValue resolves a search property: meta properties start with `$`, others are entity properties or relations.
+func propertyValue(entity map[string]any, property string) any {
+	if field, ok := strings.CutPrefix(property, "$"); ok {
+		return entity[field]
+	}
+	properties, _ := entity["properties"].(map[string]any)
+	if value, ok := properties[property]; ok {
+		return value
+	}
+	relations, _ := entity["relations"].(map[string]any)
+	return relations[property]
+}
+
+// includeFields keeps only the included fields of an entity, e.g. "identifier" or "properties.language".
+func includeFields(entity map[string]any, include []string) map[string]any {
+	if len(include) == 0 {
+		return entity
+	}
+	included := map[string]any{}
+	for _, field := range include {
+		if name, ok := strings.CutPrefix(field, "properties."); ok {
+			properties, _ := included["properties"].(map[string]any)
+			if properties == nil {
+				properties = map[string]any{}
+				included["properties"] = properties
+			}
+			if value, ok := entity["properties"].(map[string]any)[name]; ok {
+				properties[name] = value
+			}
+			continue
+		}
+		if value, ok := entity[field]; ok {
+			included[field] = value
+		}
+	}
+	return included
+}
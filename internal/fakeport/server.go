@@ -0,0 +1,291 @@
+// Package fakeport implements an in-memory fake of the Port REST API, so the provider can be tested without a live
+// Port organization. It serves the endpoints used by the internal/cli package, authenticates requests with bearer
+// tokens issued by its own access token endpoint, and answers with the `ok` and `error` bodies and the rate limit
+// headers of the real API.
+package fakeport
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	DefaultClientID     = "fake-client-id"
+	DefaultClientSecret = "fake-client-secret"
+
+	// RateLimitLimit is the amount of requests allowed in every rate limit window.
+	RateLimitLimit = 1000
+	// RateLimitWindow is the length of a rate limit window.
+	RateLimitWindow = time.Minute
+	// TokenExpiresIn is the amount of seconds the issued access tokens are valid for.
+	TokenExpiresIn = 3 * 60 * 60
+	// BulkEntitiesLimit is the amount of entities the bulk entities route accepts in a single request.
+	BulkEntitiesLimit = 20
+)
+
+// Server is a fake Port API served over HTTP. Its state is kept in memory and is shared by all its clients.
+type Server struct {
+	*httptest.Server
+
+	ClientID     string
+	ClientSecret string
+
+	mu     sync.Mutex
+	tokens map[string]bool
+	// tokenCount is used to issue unique tokens.
+	tokenCount int
+	// objectCount is used to generate unique identifiers.
+	objectCount int
+
+	collections  map[string]*collection
+	permissions  map[string]map[string]any
+	organization map[string]any
+	// systemBlueprints holds the structure of the system blueprints, which their users can extend but not shrink.
+	systemBlueprints map[string]map[string]any
+	// runStatus is the status new action and workflow runs are created with.
+	runStatus string
+	// resyncState is the state integrations report once a resync they were asked for finished.
+	resyncState map[string]any
+	// migrationStatus is the status the migrations that delete the entities of a blueprint are created with.
+	migrationStatus string
+
+	rateLimitRemaining int
+	rateLimitResetAt   time.Time
+}
+
+// NewServer starts a fake Port API that accepts the DefaultClientID and DefaultClientSecret credentials. The caller
+// should call Close when finished, to shut it down.
+func NewServer() *Server {
+	s := &Server{
+		ClientID:         DefaultClientID,
+		ClientSecret:     DefaultClientSecret,
+		tokens:           map[string]bool{},
+		collections:      map[string]*collection{},
+		permissions:      map[string]map[string]any{},
+		systemBlueprints: map[string]map[string]any{},
+		organization:     map[string]any{"name": "Fake Organization", "featureFlags": []any{}},
+		runStatus:        "IN_PROGRESS",
+		resyncState:      map[string]any{"status": "completed"},
+		migrationStatus:  "COMPLETED",
+	}
+	for _, c := range []*collection{
+		{name: "blueprint", responseKey: "blueprint", idField: "identifier"},
+		{name: "entity", responseKey: "entity", idField: "identifier"},
+		{name: "action", responseKey: "action", idField: "identifier"},
+		{name: "scorecard", responseKey: "scorecard", idField: "identifier"},
+		{name: "team", responseKey: "team", idField: "name"},
+		{name: "page", responseKey: "page", idField: "identifier"},
+		{name: "folder", responseKey: "folder", idField: "identifier"},
+		{name: "webhook", responseKey: "integration", idField: "identifier"},
+		{name: "integration", responseKey: "integration", idField: "installationId"},
+		{name: "migration", responseKey: "migration", idField: "id"},
+		{name: "secret", responseKey: "secret", idField: "secretName", hiddenFields: []string{"secretValue"}},
+		{name: "workflow", responseKey: "workflow", idField: "identifier"},
+		{name: "run", responseKey: "run", idField: "id"},
+		{name: "workflowRun", responseKey: "workflowRun", idField: "identifier"},
+	} {
+		c.objects = map[string]map[string]any{}
+		s.collections[c.name] = c
+	}
+	s.seedSystemBlueprints()
+	s.Server = httptest.NewServer(s.routes())
+	return s
+}
+
+// Object returns a copy of a stored object, e.g. Object("blueprint", "service") or Object("entity",
+// "service/my-service") for objects that are stored per blueprint.
+func (s *Server) Object(collectionName, key string) (map[string]any, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	obj, ok := s.collections[collectionName].objects[key]
+	if !ok {
+		return nil, false
+	}
+	return deepCopy(obj), true
+}
+
+// PutObject stores an object as is, bypassing the API validations. It's meant for seeding the fake with objects the
+// provider can't create, such as system blueprints or migrations.
+func (s *Server) PutObject(collectionName, key string, obj map[string]any) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.collections[collectionName].objects[key] = deepCopy(obj)
+}
+
+// OnRead calls f with every object of the collection read by its key, before it's returned, so tests can change the
+// objects the API changes over time, like the status of a run that finishes after a few reads. f is called under the
+// server lock and may modify the object.
+func (s *Server) OnRead(collectionName string, f func(obj map[string]any)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.collections[collectionName].onRead = f
+}
+
+func (s *Server) routes() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /v1/auth/access_token", s.handleAccessToken)
+
+	s.handleBlueprints(mux)
+	s.handleEntities(mux)
+	s.handleSearch(mux)
+	s.handleActions(mux)
+	s.handleScorecards(mux)
+	s.handleTeams(mux)
+	s.handlePages(mux)
+	s.handleFolders(mux)
+	s.handleWebhooks(mux)
+	s.handleIntegrations(mux)
+	s.handleMigrations(mux)
+	s.handleOrganization(mux)
+	s.handleWorkflows(mux)
+	s.handleRuns(mux)
+	s.handlePermissions(mux)
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		writeError(w, http.StatusNotFound, "not_found", fmt.Sprintf("Route %s %s was not found", r.Method, r.URL.Path))
+	})
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		s.writeRateLimitHeaders(w)
+		if s.rateLimitRemaining < 0 {
+			writeError(w, http.StatusTooManyRequests, "rate_limit_exceeded", "Too many requests, please try again later")
+			return
+		}
+		if r.URL.Path != "/v1/auth/access_token" && !s.authorized(r) {
+			writeError(w, http.StatusUnauthorized, "unauthorized", "The provided token is invalid or expired")
+			return
+		}
+		mux.ServeHTTP(w, r)
+	})
+}
+
+func (s *Server) handleAccessToken(w http.ResponseWriter, r *http.Request) {
+	var body struct {
+		ClientID     string `json:"clientId"`
+		ClientSecret string `json:"clientSecret"`
+	}
+	if !decodeBody(w, r, &body) {
+		return
+	}
+	if body.ClientID != s.ClientID || body.ClientSecret != s.ClientSecret {
+		writeError(w, http.StatusUnauthorized, "invalid_credentials", "The provided client id or client secret are invalid")
+		return
+	}
+	s.tokenCount++
+	token := fmt.Sprintf("fake-token-%d", s.tokenCount)
+	s.tokens[token] = true
+	writeJSON(w, http.StatusOK, map[string]any{
+		"ok":          true,
+		"accessToken": token,
+		"expiresIn":   TokenExpiresIn,
+		"tokenType":   "Bearer",
+	})
+}
+
+// RevokeTokens invalidates every issued access token, as if they all expired.
+func (s *Server) RevokeTokens() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.tokens = map[string]bool{}
+}
+
+func (s *Server) authorized(r *http.Request) bool {
+	token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	return ok && s.tokens[token]
+}
+
+func (s *Server) writeRateLimitHeaders(w http.ResponseWriter) {
+	now := time.Now()
+	if now.After(s.rateLimitResetAt) {
+		s.rateLimitResetAt = now.Add(RateLimitWindow)
+		s.rateLimitRemaining = RateLimitLimit
+	}
+	s.rateLimitRemaining--
+	w.Header().Set("x-ratelimit-limit", strconv.Itoa(RateLimitLimit))
+	w.Header().Set("x-ratelimit-remaining", strconv.Itoa(max(s.rateLimitRemaining, 0)))
+	w.Header().Set("x-ratelimit-reset", strconv.Itoa(int(time.Until(s.rateLimitResetAt).Seconds())))
+}
+
+// SetRateLimitRemaining overrides the remaining requests of the current rate limit window. A negative value makes the
+// following requests fail with 429 until the window resets.
+func (s *Server) SetRateLimitRemaining(remaining int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if time.Now().After(s.rateLimitResetAt) {
+		s.rateLimitResetAt = time.Now().Add(RateLimitWindow)
+	}
+	s.rateLimitRemaining = remaining
+}
+
+func (s *Server) nextID(prefix string) string {
+	s.objectCount++
+	return fmt.Sprintf("%s_%d", prefix, s.objectCount)
+}
+
+// meta sets the audit fields the API returns for every object. createdAt and createdBy are kept when replacing one.
+func (s *Server) meta(obj, previous map[string]any) {
+	now := time.Now().UTC().Format(time.RFC3339Nano)
+	obj["createdAt"], obj["createdBy"] = now, s.ClientID
+	if previous != nil {
+		obj["createdAt"], obj["createdBy"] = previous["createdAt"], previous["createdBy"]
+	}
+	obj["updatedAt"], obj["updatedBy"] = now, s.ClientID
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func writeOK(w http.ResponseWriter, status int, key string, value any) {
+	body := map[string]any{"ok": true}
+	if key != "" {
+		body[key] = value
+	}
+	writeJSON(w, status, body)
+}
+
+func writeError(w http.ResponseWriter, status int, errorName, message string) {
+	writeJSON(w, status, map[string]any{"ok": false, "error": errorName, "message": message})
+}
+
+func writeNotFound(w http.ResponseWriter, name, key string) {
+	writeError(w, http.StatusNotFound, "not_found", fmt.Sprintf("%s with identifier \"%s\" was not found", name, key))
+}
+
+func decodeBody(w http.ResponseWriter, r *http.Request, v any) bool {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		writeError(w, http.StatusUnprocessableEntity, "invalid_request", fmt.Sprintf("The request body is invalid: %s", err))
+		return false
+	}
+	return true
+}
+
+func deepCopy(obj map[string]any) map[string]any {
+	b, _ := json.Marshal(obj)
+	var copied map[string]any
+	_ = json.Unmarshal(b, &copied)
+	return copied
+}
+
+// deepMerge merges src into dst, like the PATCH endpoints of the API do.
+func deepMerge(dst, src map[string]any) {
+	for k, v := range src {
+		srcMap, srcIsMap := v.(map[string]any)
+		dstMap, dstIsMap := dst[k].(map[string]any)
+		if srcIsMap && dstIsMap {
+			deepMerge(dstMap, srcMap)
+			continue
+		}
+		dst[k] = v
+	}
+}
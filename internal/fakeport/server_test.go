@@ -0,0 +1,200 @@
+package fakeport_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cassette"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/fakeport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newClient(t *testing.T) (*fakeport.Server, *cli.PortClient) {
+	server := fakeport.NewServer()
+	t.Cleanup(server.Close)
+	c, err := cli.New(server.URL, cli.WithRetryCount(0))
+	require.NoError(t, err)
+	_, err = c.Authenticate(context.Background(), server.ClientID, server.ClientSecret)
+	require.NoError(t, err)
+	return server, c
+}
+
+func TestAuthentication(t *testing.T) {
+	ctx := context.Background()
+	server := fakeport.NewServer()
+	defer server.Close()
+
+	c, err := cli.New(server.URL, cli.WithRetryCount(0))
+	require.NoError(t, err)
+	_, err = c.Authenticate(ctx, server.ClientID, "wrong")
+	assert.Error(t, err)
+
+	resp, err := c.Client.R().SetContext(ctx).Get("v1/blueprints")
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode())
+	assert.JSONEq(t, `{"ok":false,"error":"unauthorized","message":"The provided token is invalid or expired"}`, string(resp.Body()))
+}
+
+func TestBlueprintsAndEntities(t *testing.T) {
+	ctx := context.Background()
+	server, c := newClient(t)
+
+	blueprint := &cli.Blueprint{
+		Identifier: "service",
+		Title:      "Service",
+		Schema: cli.BlueprintSchema{Properties: map[string]cli.BlueprintProperty{
+			"language": {Type: "string"},
+		}},
+	}
+	created, err := c.CreateBlueprint(ctx, blueprint, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "service", created.Identifier)
+	assert.NotNil(t, created.CreatedAt)
+
+	_, err = c.CreateBlueprint(ctx, blueprint, nil)
+	assert.ErrorContains(t, err, "identifier_taken")
+
+	_, status, err := c.ReadBlueprint(ctx, "missing")
+	assert.Error(t, err)
+	assert.Equal(t, http.StatusNotFound, status)
+
+	entity, err := c.CreateEntity(ctx, &cli.Entity{
+		Identifier: "api",
+		Title:      "API",
+		Blueprint:  "service",
+		Properties: map[string]any{"language": "Go"},
+	}, "", false)
+	require.NoError(t, err)
+	assert.Equal(t, "service", entity.Blueprint)
+
+	_, err = c.CreateEntity(ctx, &cli.Entity{
+		Blueprint:  "service",
+		Properties: map[string]any{"unknown": "value"},
+	}, "", false)
+	assert.ErrorContains(t, err, "is not defined in blueprint")
+
+	assert.ErrorContains(t, c.DeleteBlueprint(ctx, "service"), "has_dependents")
+
+	migrationID, err := c.DeleteBlueprintWithAllEntities(ctx, "service")
+	require.NoError(t, err)
+	migration, err := c.GetMigration(ctx, *migrationID)
+	require.NoError(t, err)
+	assert.Equal(t, "COMPLETED", migration.Status)
+	require.NotNil(t, migration.SuccessCount)
+	assert.Equal(t, 1, *migration.SuccessCount)
+
+	_, ok := server.Object("blueprint", "service")
+	assert.False(t, ok)
+}
+
+func TestSystemBlueprintStructure(t *testing.T) {
+	_, c := newClient(t)
+
+	structure, status, err := c.ReadSystemBlueprintStructure(context.Background(), "_user")
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, status)
+	assert.Equal(t, "_user", structure.Identifier)
+}
+
+func TestSearch(t *testing.T) {
+	ctx := context.Background()
+	_, c := newClient(t)
+
+	_, err := c.CreateBlueprint(ctx, &cli.Blueprint{Identifier: "service", Title: "Service"}, nil)
+	require.NoError(t, err)
+	for i := range 5 {
+		_, err := c.CreateEntity(ctx, &cli.Entity{Identifier: fmt.Sprintf("service-%d", i), Blueprint: "service"}, "", false)
+		require.NoError(t, err)
+	}
+
+	limit := 3
+	result, err := c.Search(ctx, &cli.SearchRequestQuery{
+		Query: &map[string]any{
+			"combinator": "and",
+			"rules": []map[string]any{
+				{"property": "$blueprint", "operator": "=", "value": "service"},
+				{"property": "$identifier", "operator": "notIn", "value": []string{"service-0"}},
+			},
+		},
+		MaxResults: &limit,
+	})
+	require.NoError(t, err)
+	assert.Len(t, result.Entities, 3)
+	assert.True(t, result.Truncated)
+	assert.Equal(t, []string{"service"}, result.MatchingBlueprints)
+	assert.Equal(t, "service-1", result.Entities[0].Identifier)
+
+	_, err = c.Search(ctx, &cli.SearchRequestQuery{
+		Query: &map[string]any{"rules": []map[string]any{{"property": "$title", "operator": "between", "value": "a"}}},
+	})
+	assert.ErrorContains(t, err, "not supported")
+}
+
+func TestOrganizationSecretValueIsHidden(t *testing.T) {
+	ctx := context.Background()
+	server, c := newClient(t)
+
+	value := "s3cr3t"
+	secret, err := c.CreateOrganizationSecret(ctx, &cli.OrganizationSecret{SecretName: "token", SecretValue: &value})
+	require.NoError(t, err)
+	assert.Nil(t, secret.SecretValue)
+
+	stored, ok := server.Object("secret", "token")
+	require.True(t, ok)
+	assert.Equal(t, value, stored["secretValue"])
+}
+
+func TestRateLimitHeaders(t *testing.T) {
+	server, c := newClient(t)
+	ctx := context.Background()
+
+	resp, err := c.Client.R().SetContext(ctx).Get("v1/organization")
+	require.NoError(t, err)
+	assert.Equal(t, fmt.Sprint(fakeport.RateLimitLimit), resp.Header().Get("x-ratelimit-limit"))
+	assert.NotEmpty(t, resp.Header().Get("x-ratelimit-remaining"))
+
+	server.SetRateLimitRemaining(-1)
+	resp, err = c.Client.R().SetContext(ctx).Get("v1/organization")
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusTooManyRequests, resp.StatusCode())
+	assert.Equal(t, "0", resp.Header().Get("x-ratelimit-remaining"))
+}
+
+func TestRevokedTokens(t *testing.T) {
+	server, c := newClient(t)
+
+	server.RevokeTokens()
+	_, status, err := c.ReadBlueprint(context.Background(), "_team")
+	// The client doesn't refresh a token it has just been issued, so the fake's 401 is returned as is.
+	assert.Error(t, err)
+	assert.Equal(t, http.StatusUnauthorized, status)
+}
+
+func TestRecordAndReplayCassette(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	server := fakeport.NewServer()
+
+	t.Setenv(cassette.RecordEnvVar, dir)
+	recording, err := cli.New(server.URL, cli.WithRetryCount(0))
+	require.NoError(t, err)
+	_, err = recording.Authenticate(ctx, server.ClientID, server.ClientSecret)
+	require.NoError(t, err)
+	_, err = recording.CreateBlueprint(ctx, &cli.Blueprint{Identifier: "service", Title: "Service"}, nil)
+	require.NoError(t, err)
+	server.Close()
+
+	t.Setenv(cassette.RecordEnvVar, "")
+	t.Setenv(cassette.ReplayEnvVar, dir)
+	replaying, err := cli.New(server.URL, cli.WithRetryCount(0))
+	require.NoError(t, err)
+	_, err = replaying.Authenticate(ctx, server.ClientID, server.ClientSecret)
+	require.NoError(t, err)
+	blueprint, err := replaying.CreateBlueprint(ctx, &cli.Blueprint{Identifier: "service", Title: "Service"}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "service", blueprint.Identifier)
+}
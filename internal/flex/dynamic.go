@@ -0,0 +1,151 @@
+package flex
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// GoValueToFrameworkDynamic converts a decoded JSON value to a dynamic value. Objects become Terraform objects and
+// arrays become tuples, like Terraform's jsondecode.
+func GoValueToFrameworkDynamic(v any) (types.Dynamic, error) {
+	value, err := goValueToFramework(v)
+	if err != nil {
+		return types.DynamicNull(), err
+	}
+	return types.DynamicValue(value), nil
+}
+
+func goValueToFramework(v any) (attr.Value, error) {
+	switch v := v.(type) {
+	case nil:
+		return types.DynamicNull(), nil
+	case string:
+		return types.StringValue(v), nil
+	case bool:
+		return types.BoolValue(v), nil
+	case int:
+		return types.NumberValue(new(big.Float).SetInt64(int64(v))), nil
+	case int64:
+		return types.NumberValue(new(big.Float).SetInt64(v)), nil
+	case float64:
+		return types.NumberValue(big.NewFloat(v)), nil
+	case *big.Int:
+		return types.NumberValue(new(big.Float).SetInt(v)), nil
+	case []any:
+		elemTypes := make([]attr.Type, 0, len(v))
+		elems := make([]attr.Value, 0, len(v))
+		for _, item := range v {
+			elem, err := goValueToFramework(item)
+			if err != nil {
+				return nil, err
+			}
+			elemTypes = append(elemTypes, elem.Type(context.Background()))
+			elems = append(elems, elem)
+		}
+		tuple, diags := types.TupleValue(elemTypes, elems)
+		if diags.HasError() {
+			return nil, fmt.Errorf("failed to convert array: %s", diags.Errors()[0].Detail())
+		}
+		return tuple, nil
+	case map[string]any:
+		attrTypes := make(map[string]attr.Type, len(v))
+		attrs := make(map[string]attr.Value, len(v))
+		for key, item := range v {
+			value, err := goValueToFramework(item)
+			if err != nil {
+				return nil, err
+			}
+			attrTypes[key] = value.Type(context.Background())
+			attrs[key] = value
+		}
+		object, diags := types.ObjectValue(attrTypes, attrs)
+		if diags.HasError() {
+			return nil, fmt.Errorf("failed to convert object: %s", diags.Errors()[0].Detail())
+		}
+		return object, nil
+	default:
+		return nil, fmt.Errorf("unsupported value type %T", v)
+	}
+}
+
+// FrameworkDynamicToGoValue converts a dynamic value to the value jsonencode would encode it as. Numbers that are
+// whole become int64, so they encode without an exponent.
+func FrameworkDynamicToGoValue(ctx context.Context, v types.Dynamic) (any, error) {
+	if v.IsNull() || v.IsUnderlyingValueNull() {
+		return nil, nil
+	}
+	if v.IsUnknown() || v.IsUnderlyingValueUnknown() {
+		return nil, fmt.Errorf("value is unknown")
+	}
+	value, err := v.UnderlyingValue().ToTerraformValue(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return terraformValueToGo(value)
+}
+
+func terraformValueToGo(v tftypes.Value) (any, error) {
+	if v.IsNull() {
+		return nil, nil
+	}
+	if !v.IsKnown() {
+		return nil, fmt.Errorf("value is unknown")
+	}
+
+	typ := v.Type()
+	switch {
+	case typ.Is(tftypes.String):
+		var s string
+		err := v.As(&s)
+		return s, err
+	case typ.Is(tftypes.Bool):
+		var b bool
+		err := v.As(&b)
+		return b, err
+	case typ.Is(tftypes.Number):
+		var f big.Float
+		if err := v.As(&f); err != nil {
+			return nil, err
+		}
+		if i, accuracy := f.Int64(); accuracy == big.Exact {
+			return i, nil
+		}
+		n, _ := f.Float64()
+		return n, nil
+	case typ.Is(tftypes.List{}), typ.Is(tftypes.Set{}), typ.Is(tftypes.Tuple{}):
+		var elems []tftypes.Value
+		if err := v.As(&elems); err != nil {
+			return nil, err
+		}
+		items := make([]any, 0, len(elems))
+		for _, elem := range elems {
+			item, err := terraformValueToGo(elem)
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, item)
+		}
+		return items, nil
+	case typ.Is(tftypes.Map{}), typ.Is(tftypes.Object{}):
+		var attrs map[string]tftypes.Value
+		if err := v.As(&attrs); err != nil {
+			return nil, err
+		}
+		object := make(map[string]any, len(attrs))
+		for key, attr := range attrs {
+			item, err := terraformValueToGo(attr)
+			if err != nil {
+				return nil, err
+			}
+			object[key] = item
+		}
+		return object, nil
+	default:
+		return nil, fmt.Errorf("unsupported value type %s", typ)
+	}
+}
@@ -0,0 +1,25 @@
+package flex
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// NullTimeouts returns a null `timeouts` block with the given options, for states that aren't read from Terraform, like
+// the resources of list results. The zero value of timeouts.Value doesn't have the attribute types of the block.
+func NullTimeouts(ctx context.Context, opts timeouts.Opts) timeouts.Value {
+	blockType := timeouts.Block(ctx, opts).Type().(timeouts.Type)
+	return timeouts.Value{Object: types.ObjectNull(blockType.AttrTypes)}
+}
+
+// TimeoutIsSet reports whether the `timeouts` block sets the timeout of the operation, like "create", instead of
+// leaving it to its default.
+func TimeoutIsSet(t timeouts.Value, operation string) bool {
+	if t.IsNull() || t.IsUnknown() {
+		return false
+	}
+	timeout, ok := t.Attributes()[operation]
+	return ok && !timeout.IsNull() && !timeout.IsUnknown()
+}
@@ -0,0 +1,92 @@
+// Package jq parses the JQ expressions of mappings, actions and workflows, so that their syntax errors are reported by
+// `terraform validate` rather than by the API at apply time. Expressions are only parsed: the functions and variables
+// they use are resolved by Port when it evaluates them.
+package jq
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/itchyny/gojq"
+)
+
+// Parse parses a JQ expression. Port also accepts single quoted strings, e.g. `'and'`, which are rewritten to the
+// double quoted strings of JQ before parsing.
+func Parse(expression string) (*gojq.Query, error) {
+	query, err := gojq.Parse(normalizeQuotes(expression))
+	if err != nil {
+		return nil, fmt.Errorf("invalid JQ expression %q: %s", expression, err)
+	}
+	return query, nil
+}
+
+func normalizeQuotes(expression string) string {
+	var b strings.Builder
+	inString := false
+	for i := 0; i < len(expression); i++ {
+		c := expression[i]
+		switch {
+		case inString:
+			b.WriteByte(c)
+			if c == '\\' && i+1 < len(expression) {
+				i++
+				b.WriteByte(expression[i])
+			} else if c == '"' {
+				inString = false
+			}
+		case c == '"':
+			inString = true
+			b.WriteByte(c)
+		case c == '\'':
+			end := strings.IndexByte(expression[i+1:], '\'')
+			if end < 0 {
+				b.WriteString(expression[i:])
+				return b.String()
+			}
+			b.WriteString(strconv.Quote(expression[i+1 : i+1+end]))
+			i += end + 1
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return b.String()
+}
+
+// Evaluate returns all the outputs of the expression on the input, which must be a decoded JSON value.
+func Evaluate(ctx context.Context, expression string, input any) ([]any, error) {
+	query, err := Parse(expression)
+	if err != nil {
+		return nil, err
+	}
+
+	outputs := []any{}
+	iter := query.RunWithContext(ctx, input)
+	for {
+		output, ok := iter.Next()
+		if !ok {
+			return outputs, nil
+		}
+		if err, ok := output.(error); ok {
+			if err, ok := err.(*gojq.HaltError); ok && err.Value() == nil {
+				return outputs, nil
+			}
+			return nil, fmt.Errorf("failed to evaluate the JQ expression %q: %s", expression, err)
+		}
+		outputs = append(outputs, output)
+	}
+}
+
+var templatePattern = regexp.MustCompile(`(?s){{(.*?)}}`)
+
+// ParseTemplate parses the expressions of a template, the `{{ expression }}` placeholders in the payloads of actions.
+func ParseTemplate(template string) error {
+	for _, match := range templatePattern.FindAllStringSubmatch(template, -1) {
+		if _, err := Parse(match[1]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
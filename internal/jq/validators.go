@@ -0,0 +1,141 @@
+package jq
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/samber/lo"
+)
+
+type stringValidator struct {
+	summary     string
+	description string
+	validate    func(string) error
+}
+
+func (v stringValidator) Description(context.Context) string { return v.description }
+
+func (v stringValidator) MarkdownDescription(context.Context) string { return v.description }
+
+func (v stringValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	if err := v.validate(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, v.summary, err.Error())
+	}
+}
+
+// ExpressionValidator validates that the value is a JQ expression.
+func ExpressionValidator() validator.String {
+	return stringValidator{
+		summary:     "Invalid JQ expression",
+		description: "must be a valid JQ expression",
+		validate: func(value string) error {
+			_, err := Parse(value)
+			return err
+		},
+	}
+}
+
+// TemplateValidator validates the `{{ expression }}` placeholders of the value, if any.
+func TemplateValidator() validator.String {
+	return stringValidator{
+		summary:     "Invalid JQ template",
+		description: "the expressions between `{{` and `}}` must be valid JQ expressions",
+		validate:    ParseTemplate,
+	}
+}
+
+// MappingValidator validates the identifier and relations of webhook mappings, which are either a JQ expression or a
+// JSON encoded search query whose strings are all JQ expressions.
+func MappingValidator() validator.String {
+	return stringValidator{
+		summary:     "Invalid JQ expression",
+		description: "must be a valid JQ expression, or a JSON encoded search query of JQ expressions",
+		validate: func(value string) error {
+			var object map[string]any
+			if err := json.Unmarshal([]byte(value), &object); err != nil {
+				_, err = Parse(value)
+				return err
+			}
+			return walkStrings(object, nil, func(path []string, s string) error {
+				if _, err := Parse(s); err != nil {
+					return fmt.Errorf("%s: %s", strings.Join(path, ""), err)
+				}
+				return nil
+			})
+		},
+	}
+}
+
+// Both the RBAC policy and the responders entity query are `{combinator, rules}`
+// objects. The rules themselves are a large union that is left to the API, so
+// only the envelope and the `jqQuery` values of the rules are checked here.
+func QueryValidator(summary string) validator.String {
+	return stringValidator{
+		summary:     summary,
+		description: "must be a JSON encoded object with a `combinator` of `and` or `or` and a `rules` array",
+		validate: func(value string) error {
+			var decoded any
+			if err := json.Unmarshal([]byte(value), &decoded); err != nil {
+				return fmt.Errorf("must be valid JSON: %s", err)
+			}
+			object, ok := decoded.(map[string]any)
+			if !ok {
+				return fmt.Errorf("must be a JSON object")
+			}
+
+			combinator, ok := object["combinator"].(string)
+			if !ok || (combinator != "and" && combinator != "or") {
+				return fmt.Errorf("must set `combinator` to `and` or `or`")
+			}
+
+			rules, ok := object["rules"].([]any)
+			if !ok {
+				return fmt.Errorf("must set `rules` to an array")
+			}
+			return walkStrings(rules, []string{"rules"}, func(path []string, s string) error {
+				if len(path) == 0 || path[len(path)-1] != ".jqQuery" {
+					return nil
+				}
+				if _, err := Parse(s); err != nil {
+					return fmt.Errorf("%s: %s", strings.Join(path, ""), err)
+				}
+				return nil
+			})
+		},
+	}
+}
+
+// walkStrings calls visit with every string of a decoded JSON value and its path, e.g. `rules[0].value`, in a stable
+// order so that the first error reported doesn't change between runs.
+func walkStrings(value any, path []string, visit func(path []string, s string) error) error {
+	switch value := value.(type) {
+	case string:
+		return visit(path, value)
+	case []any:
+		for i, item := range value {
+			if err := walkStrings(item, append(slices.Clone(path), fmt.Sprintf("[%d]", i)), visit); err != nil {
+				return err
+			}
+		}
+	case map[string]any:
+		keys := lo.Keys(value)
+		slices.Sort(keys)
+		for _, key := range keys {
+			segment := "." + key
+			if len(path) == 0 {
+				segment = key
+			}
+			if err := walkStrings(value[key], append(slices.Clone(path), segment), visit); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
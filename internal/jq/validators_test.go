@@ -0,0 +1,94 @@
+package jq
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func runStringValidator(v validator.String, value string) diag.Diagnostics {
+	resp := &validator.StringResponse{}
+	v.ValidateString(context.Background(), validator.StringRequest{
+		Path:        path.Root("attribute"),
+		ConfigValue: types.StringValue(value),
+	}, resp)
+	return resp.Diagnostics
+}
+
+func TestExpressionValidator(t *testing.T) {
+	valid := []string{
+		".body.pull_request.id | tostring",
+		"'Test'",
+		"'example' | [ . ]",
+		`if .form.priority == "high" then 5 elif .form.priority == "medium" then 3 else 1 end`,
+		"(6 - ((.form.reviewers // []) | length)) as $n | if $n < 0 then 0 else $n end",
+		`.headers."x-github-event" == "push"`,
+		`"it's" + .name`,
+	}
+	for _, expression := range valid {
+		assert.Empty(t, runStringValidator(ExpressionValidator(), expression).Errors(), expression)
+	}
+
+	invalid := []string{".body.", "if .a then 1", "[1, 2", "'unterminated"}
+	for _, expression := range invalid {
+		assert.NotEmpty(t, runStringValidator(ExpressionValidator(), expression).Errors(), expression)
+	}
+}
+
+func TestExpressionValidatorReportsAttributePath(t *testing.T) {
+	diags := runStringValidator(ExpressionValidator(), ".body.")
+	require.Len(t, diags, 1)
+	assert.Equal(t, "Invalid JQ expression", diags[0].Summary())
+	assert.Contains(t, diags[0].Detail(), `invalid JQ expression ".body."`)
+	assert.Equal(t, path.Root("attribute"), diags[0].(diag.DiagnosticWithPath).Path())
+}
+
+func TestTemplateValidator(t *testing.T) {
+	valid := []string{`{"runId":"{{run.id}}"}`, "{{.entity.teams}}", "true", "no templates", "{{ .inputs.name | ascii_downcase }}-service"}
+	for _, template := range valid {
+		assert.Empty(t, runStringValidator(TemplateValidator(), template).Errors(), template)
+	}
+
+	invalid := []string{`{"runId":"{{run.}}"}`, "{{ .inputs.name | }}"}
+	for _, template := range invalid {
+		assert.NotEmpty(t, runStringValidator(TemplateValidator(), template).Errors(), template)
+	}
+}
+
+func TestMappingValidator(t *testing.T) {
+	valid := []string{
+		".body.resources[0]",
+		`{"combinator":"'and'","rules":[{"property":"'arn'","operator":"'='","value":".body.resources[0]"}]}`,
+	}
+	for _, value := range valid {
+		assert.Empty(t, runStringValidator(MappingValidator(), value).Errors(), value)
+	}
+
+	diags := runStringValidator(MappingValidator(), `{"combinator":"'and'","rules":[{"property":"'arn'","operator":"'='","value":".body.resources["}]}`)
+	require.Len(t, diags, 1)
+	assert.Contains(t, diags[0].Detail(), "rules[0].value: ")
+}
+
+func TestQueryValidator(t *testing.T) {
+	valid := []string{
+		`{"combinator":"and","rules":[{"property":{"context":"user","property":"department"},"operator":"=","value":"engineering"}]}`,
+		`{"combinator":"or","rules":[{"property":"$team","operator":"containsAny","value":{"jqQuery":".user.teams | map(.name)"}}]}`,
+	}
+	for _, value := range valid {
+		assert.Empty(t, runStringValidator(QueryValidator("Invalid policy"), value).Errors(), value)
+	}
+
+	invalid := []string{
+		`{"combinator":"maybe","rules":[]}`, `{"rules":[]}`, `{"combinator":"and"}`, `"and"`, `{`,
+		`{"combinator":"and","rules":[{"combinator":"or","rules":[{"property":"$team","operator":"in","value":{"jqQuery":".user.teams |"}}]}]}`,
+	}
+	for _, value := range invalid {
+		assert.NotEmpty(t, runStringValidator(QueryValidator("Invalid policy"), value).Errors(), value)
+	}
+}
@@ -0,0 +1,48 @@
+// Package poll waits for the asynchronous operations of Port, like action runs, integration resyncs and migrations,
+// by reading them until they finish.
+package poll
+
+import (
+	"context"
+	"time"
+
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/utils"
+)
+
+// TimeoutError is returned by Until when the context is done before the operation finishes, including while the
+// operation is being read.
+const TimeoutError = utils.StringErr("timed out waiting for the operation to finish")
+
+// interval is how long Until waits between reads of an operation that didn't finish yet.
+var interval = 5 * time.Second
+
+// SetInterval sets how long Until waits between reads, so tests don't wait for the default interval. It returns a
+// function that restores the previous one.
+func SetInterval(d time.Duration) func() {
+	previous := interval
+	interval = d
+	return func() { interval = previous }
+}
+
+// Until calls read until it reports that the operation finished, waiting the poll interval between calls. It returns
+// the error of read, or TimeoutError once ctx is done.
+func Until(ctx context.Context, read func(ctx context.Context) (bool, error)) error {
+	for {
+		done, err := read(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return TimeoutError
+			}
+			return err
+		}
+		if done {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return TimeoutError
+		case <-time.After(interval):
+		}
+	}
+}
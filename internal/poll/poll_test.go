@@ -0,0 +1,48 @@
+package poll
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUntil(t *testing.T) {
+	defer SetInterval(10 * time.Millisecond)()
+	ctx := context.Background()
+
+	t.Run("finishes", func(t *testing.T) {
+		reads := 0
+		err := Until(ctx, func(ctx context.Context) (bool, error) {
+			reads++
+			return reads == 3, nil
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, 3, reads)
+	})
+
+	t.Run("read error", func(t *testing.T) {
+		readErr := errors.New("not found")
+		err := Until(ctx, func(ctx context.Context) (bool, error) { return false, readErr })
+		assert.Equal(t, readErr, err)
+	})
+
+	t.Run("times out between reads", func(t *testing.T) {
+		timeoutCtx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+		defer cancel()
+		err := Until(timeoutCtx, func(ctx context.Context) (bool, error) { return false, nil })
+		assert.ErrorIs(t, err, TimeoutError)
+	})
+
+	t.Run("times out during a read", func(t *testing.T) {
+		timeoutCtx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+		defer cancel()
+		err := Until(timeoutCtx, func(ctx context.Context) (bool, error) {
+			<-ctx.Done()
+			return false, ctx.Err()
+		})
+		assert.ErrorIs(t, err, TimeoutError, "the failed read is reported as a timeout")
+	})
+}
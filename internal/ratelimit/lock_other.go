@@ -0,0 +1,13 @@
+//go:build !unix
+
+package ratelimit
+
+import "os"
+
+func lockFile(_ *os.File) error {
+	return errSharedStateUnsupported
+}
+
+func unlockFile(_ *os.File) error {
+	return errSharedStateUnsupported
+}
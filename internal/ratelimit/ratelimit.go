@@ -6,7 +6,9 @@ import (
 	"io"
 	"log/slog"
 	"math/rand/v2"
+	"net/http"
 	"strconv"
+	"sync"
 	"sync/atomic"
 	"time"
 
@@ -25,7 +27,12 @@ type Options struct {
 	Logger             *slog.Logger
 	MinRequestInterval *time.Duration
 	Enabled            *bool
-	Ctx                context.Context
+	// MaxConcurrency limits the amount of requests sent to Port at the same time. Zero means no limit.
+	MaxConcurrency *int
+	// SharedStateFile is the path of a state file through which the managers of several provider processes on the
+	// same host share the rate limit budget reported by Port. Empty means the budget is tracked per process.
+	SharedStateFile string
+	Ctx             context.Context
 }
 
 func DefaultOptions() *Options {
@@ -33,6 +40,7 @@ func DefaultOptions() *Options {
 		Logger:             slog.New(slog.NewTextHandler(io.Discard, nil)),
 		MinRequestInterval: utils.PtrTo(50 * time.Millisecond),
 		Enabled:            utils.PtrTo(true),
+		MaxConcurrency:     utils.PtrTo(0),
 		Ctx:                context.Background(),
 	}
 }
@@ -42,6 +50,10 @@ type Manager struct {
 	lastRequestTime    atomic.Pointer[time.Time]
 	minRequestInterval time.Duration
 	logger             *slog.Logger
+	// concurrency holds a slot for every request in flight when MaxConcurrency is set.
+	concurrency chan struct{}
+	// shared is set when the rate limit budget is shared with other processes.
+	shared *sharedState
 
 	info      atomic.Pointer[Info]
 	remaining atomic.Int64
@@ -69,13 +81,18 @@ func New(opts *Options) *Manager {
 	if enabled == nil {
 		enabled = defaults.Enabled
 	}
+	maxConcurrency := opts.MaxConcurrency
+	if maxConcurrency == nil {
+		maxConcurrency = defaults.MaxConcurrency
+	}
 	baseCtx := opts.Ctx
 	if baseCtx == nil {
 		baseCtx = defaults.Ctx
 	}
 
 	logger = logger.WithGroup("ratelimit").
-		With("enabled", *enabled, "minRequestInterval", *minRequestInterval)
+		With("enabled", *enabled, "minRequestInterval", *minRequestInterval, "maxConcurrency", *maxConcurrency,
+			"sharedStateFile", opts.SharedStateFile)
 
 	ctx, cancel := context.WithCancel(baseCtx)
 
@@ -86,6 +103,12 @@ func New(opts *Options) *Manager {
 		ctx:                ctx,
 		cancelCtxFunc:      cancel,
 	}
+	if *maxConcurrency > 0 {
+		manager.concurrency = make(chan struct{}, *maxConcurrency)
+	}
+	if opts.SharedStateFile != "" {
+		manager.shared = &sharedState{path: opts.SharedStateFile}
+	}
 
 	logger.Debug("ratelimit.Manager initialized")
 	return manager
@@ -119,25 +142,118 @@ func (m *Manager) Allow() bool {
 		return true
 	}
 
+	if m.shared != nil {
+		throttlingDelay, remaining, err := m.reserveShared()
+		if err == nil {
+			m.throttle(throttlingDelay, remaining)
+			return true
+		}
+		m.logger.Debug("Failed to use the shared rate limit state - falling back to the local one", "error", err)
+	}
+
 	lastRequestTime := m.lastRequestTime.Load()
 	defer m.lastRequestTime.Store(utils.PtrTo(time.Now()))
 
 	remaining := m.remaining.Add(-1)
 	info := m.GetInfo()
 
-	if throttlingDelay := m.calculateDelay(lastRequestTime, remaining, info); throttlingDelay > 0 {
-		m.logger.Debug("Throttling request", "delay", throttlingDelay, "remaining", remaining)
-		select {
-		case <-m.ctx.Done():
-			m.logger.Debug("Rate limiting context cancelled - stopping delay", "remaining", remaining)
-			return true
-		case <-time.After(throttlingDelay):
-		}
-	} else {
+	m.throttle(m.calculateDelay(lastRequestTime, remaining, info), remaining)
+	return true
+}
+
+func (m *Manager) throttle(throttlingDelay time.Duration, remaining int64) {
+	if throttlingDelay <= 0 {
 		m.logger.Debug("Not throttling", "remaining", remaining)
+		return
 	}
+	m.logger.Debug("Throttling request", "delay", throttlingDelay, "remaining", remaining)
+	select {
+	case <-m.ctx.Done():
+		m.logger.Debug("Rate limiting context cancelled - stopping delay", "remaining", remaining)
+	case <-time.After(throttlingDelay):
+	}
+}
 
-	return true
+// reserveShared takes a request from the shared budget and returns how long to wait before sending it. The time the
+// request will be sent at is stored right away, so the minimum request interval also applies across processes.
+func (m *Manager) reserveShared() (time.Duration, int64, error) {
+	var throttlingDelay time.Duration
+	var remaining int64
+	err := m.shared.update(func(data *sharedStateData) {
+		now := time.Now()
+		var lastRequestTime *time.Time
+		if data.LastRequestAt != 0 {
+			lastRequestTime = utils.PtrTo(time.Unix(0, data.LastRequestAt))
+		}
+
+		data.Remaining--
+		remaining = data.Remaining
+		throttlingDelay = m.calculateDelay(lastRequestTime, remaining, data.info(now))
+		data.LastRequestAt = now.Add(throttlingDelay).UnixNano()
+	})
+	return throttlingDelay, remaining, err
+}
+
+// updateShared stores the rate limit budget reported by Port in the shared state. Responses of other processes may
+// arrive out of order, so within the same rate limit window the lowest remaining budget wins.
+func (m *Manager) updateShared(remaining int64, info *Info) error {
+	return m.shared.update(func(data *sharedStateData) {
+		now := time.Now()
+		resetAt := now.Add(time.Duration(info.Reset) * time.Second).UnixMilli()
+		sameWindow := data.info(now) != nil && max(resetAt-data.ResetAt, data.ResetAt-resetAt) < 2*time.Second.Milliseconds()
+		if sameWindow {
+			data.Remaining = min(data.Remaining, remaining)
+		} else {
+			data.Remaining = remaining
+			data.ResetAt = resetAt
+		}
+		data.Limit = info.Limit
+	})
+}
+
+// Transport wraps an HTTP transport so that no more than MaxConcurrency requests are in flight at the same time. A
+// request holds its slot until its response body is closed, and waits for a free slot until its context is done.
+func (m *Manager) Transport(next http.RoundTripper) http.RoundTripper {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	if !m.enabled || m.concurrency == nil {
+		return next
+	}
+	return roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		select {
+		case m.concurrency <- struct{}{}:
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		}
+		release := sync.OnceFunc(func() { <-m.concurrency })
+		m.logger.Debug("Acquired concurrency slot", "inFlight", len(m.concurrency))
+
+		resp, err := next.RoundTrip(req)
+		if err != nil || resp.Body == nil {
+			release()
+			return resp, err
+		}
+		resp.Body = &releasingBody{ReadCloser: resp.Body, release: release}
+		return resp, nil
+	})
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// releasingBody releases the concurrency slot of its request once it's closed.
+type releasingBody struct {
+	io.ReadCloser
+	release func()
+}
+
+func (b *releasingBody) Close() error {
+	defer b.release()
+	return b.ReadCloser.Close()
 }
 
 func (m *Manager) ResponseMiddleware(_ *resty.Client, resp *resty.Response) error {
@@ -190,6 +306,12 @@ func (m *Manager) ResponseMiddleware(_ *resty.Client, resp *resty.Response) erro
 	m.logger.Debug("Parsed rate limit info", "new_rate_limit_info", rateLimitInfo,
 		"old_rate_limit_info", oldRateLimitInfo, "remaining", remaining)
 
+	if m.shared != nil {
+		if err := m.updateShared(remaining, rateLimitInfo); err != nil {
+			m.logger.Debug("Failed to update the shared rate limit state", "error", err)
+		}
+	}
+
 	return nil
 }
 
@@ -291,3 +291,50 @@ func TestNewManagerWithOptions(t *testing.T) {
 		assert.Equal(t, minInterval, manager.minRequestInterval)
 	})
 }
+
+func TestMaxConcurrency(t *testing.T) {
+	manager := New(&Options{MaxConcurrency: utils.PtrTo(2), MinRequestInterval: utils.PtrTo(time.Duration(0))})
+	t.Cleanup(manager.Close)
+	var inFlight, maxInFlight atomic.Int64
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		current := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			seen := maxInFlight.Load()
+			if current <= seen || maxInFlight.CompareAndSwap(seen, current) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"ok": true}`))
+	}))
+	defer server.Close()
+
+	client := resty.New().SetBaseURL(server.URL).
+		SetRateLimiter(manager).
+		SetTransport(manager.Transport(nil))
+
+	numRequests := 8
+	results := make(chan error, numRequests)
+	for range numRequests {
+		go func() {
+			_, err := client.R().Get("/test")
+			results <- err
+		}()
+	}
+	for range numRequests {
+		assert.NoError(t, <-results)
+	}
+
+	assert.Equal(t, int64(2), maxInFlight.Load())
+	assert.Empty(t, manager.concurrency, "every slot should be released")
+}
+
+func TestMaxConcurrencyDisabled(t *testing.T) {
+	manager := New(&Options{MaxConcurrency: utils.PtrTo(1), Enabled: utils.PtrTo(false)})
+	t.Cleanup(manager.Close)
+
+	assert.Equal(t, http.DefaultTransport, manager.Transport(nil))
+}
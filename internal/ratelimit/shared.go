@@ -0,0 +1,77 @@
+package ratelimit
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"time"
+)
+
+var errSharedStateUnsupported = errors.New("sharing the rate limit budget is not supported on this platform")
+
+// sharedState coordinates the rate limit budget of several provider processes on the same host through a state file.
+// Every access to the file holds an exclusive lock on it, so the processes see each other's requests.
+type sharedState struct {
+	path string
+}
+
+// sharedStateData is the content of the shared state file.
+type sharedStateData struct {
+	Limit     int   `json:"limit"`
+	Remaining int64 `json:"remaining"`
+	// ResetAt is when the rate limit budget resets, in unix milliseconds.
+	ResetAt int64 `json:"resetAt"`
+	// LastRequestAt is when the last request of any process was sent, or is scheduled to be sent, in unix nanoseconds.
+	LastRequestAt int64 `json:"lastRequestAt"`
+}
+
+// info returns the rate limit info of the shared budget, or nil when the budget is unknown or already reset.
+func (d *sharedStateData) info(now time.Time) *Info {
+	if d.Limit <= 0 || d.ResetAt == 0 {
+		return nil
+	}
+	untilReset := time.UnixMilli(d.ResetAt).Sub(now)
+	if untilReset <= 0 {
+		return nil
+	}
+	return &Info{Limit: d.Limit, Reset: int(untilReset.Round(time.Second) / time.Second)}
+}
+
+// update calls fn with the current shared state while holding the lock on the state file, and writes back the changes
+// fn made to it. A missing or corrupted state file is treated as empty.
+func (s *sharedState) update(fn func(*sharedStateData)) error {
+	f, err := os.OpenFile(s.path, os.O_RDWR|os.O_CREATE, 0o600)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	if err = lockFile(f); err != nil {
+		return err
+	}
+	defer func() { _ = unlockFile(f) }()
+
+	var data sharedStateData
+	content, err := io.ReadAll(f)
+	if err != nil {
+		return err
+	}
+	if len(content) > 0 {
+		if err = json.Unmarshal(content, &data); err != nil {
+			data = sharedStateData{}
+		}
+	}
+
+	fn(&data)
+
+	content, err = json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	if err = f.Truncate(0); err != nil {
+		return err
+	}
+	_, err = f.WriteAt(content, 0)
+	return err
+}
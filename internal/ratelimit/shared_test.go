@@ -0,0 +1,103 @@
+//go:build unix
+
+package ratelimit
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newSharedManagers(t *testing.T, minRequestInterval time.Duration) (*Manager, *Manager) {
+	path := filepath.Join(t.TempDir(), "ratelimit.json")
+	opts := &Options{MinRequestInterval: &minRequestInterval, SharedStateFile: path}
+	first, second := New(opts), New(opts)
+	t.Cleanup(first.Close)
+	t.Cleanup(second.Close)
+	return first, second
+}
+
+func TestSharedBudget(t *testing.T) {
+	first, second := newSharedManagers(t, 0)
+
+	require.NoError(t, first.updateShared(2, &Info{Limit: 10, Reset: 60}))
+
+	delay, remaining, err := second.reserveShared()
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), remaining)
+	assert.Zero(t, delay)
+
+	delay, remaining, err = first.reserveShared()
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), remaining)
+	assert.GreaterOrEqual(t, delay, 59*time.Second, "the budget used by the other manager should be taken into account")
+
+	// a late response from the same window doesn't restore the budget
+	require.NoError(t, second.updateShared(5, &Info{Limit: 10, Reset: 60}))
+	_, remaining, err = second.reserveShared()
+	require.NoError(t, err)
+	assert.Equal(t, int64(-1), remaining)
+
+	// a new window replaces it
+	require.NoError(t, second.updateShared(5, &Info{Limit: 10, Reset: 120}))
+	_, remaining, err = first.reserveShared()
+	require.NoError(t, err)
+	assert.Equal(t, int64(4), remaining)
+}
+
+func TestSharedBudgetReset(t *testing.T) {
+	first, second := newSharedManagers(t, 0)
+
+	require.NoError(t, first.shared.update(func(data *sharedStateData) {
+		data.Limit = 10
+		data.Remaining = 0
+		data.ResetAt = time.Now().Add(-time.Second).UnixMilli()
+	}))
+
+	delay, _, err := second.reserveShared()
+	require.NoError(t, err)
+	assert.Zero(t, delay, "an expired budget shouldn't throttle")
+}
+
+func TestSharedMinRequestInterval(t *testing.T) {
+	first, second := newSharedManagers(t, time.Second)
+
+	delay, _, err := first.reserveShared()
+	require.NoError(t, err)
+	assert.Zero(t, delay)
+
+	delay, _, err = second.reserveShared()
+	require.NoError(t, err)
+	assert.Greater(t, delay, 900*time.Millisecond)
+	assert.LessOrEqual(t, delay, time.Second)
+
+	delay, _, err = first.reserveShared()
+	require.NoError(t, err)
+	assert.Greater(t, delay, 1900*time.Millisecond, "requests scheduled by other managers should be waited for")
+}
+
+func TestSharedStateCorrupted(t *testing.T) {
+	first, _ := newSharedManagers(t, 0)
+	require.NoError(t, os.WriteFile(first.shared.path, []byte("not json"), 0o600))
+
+	delay, remaining, err := first.reserveShared()
+	require.NoError(t, err)
+	assert.Zero(t, delay)
+	assert.Equal(t, int64(-1), remaining)
+}
+
+func TestSharedStateFallback(t *testing.T) {
+	manager := New(&Options{
+		MinRequestInterval: utils.PtrTo(time.Duration(0)),
+		SharedStateFile:    filepath.Join(t.TempDir(), "missing", "ratelimit.json"),
+	})
+	t.Cleanup(manager.Close)
+
+	assert.True(t, manager.Allow(), "an unusable state file should fall back to the local budget")
+	assert.Equal(t, int64(-1), manager.remaining.Load())
+}
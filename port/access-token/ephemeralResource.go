@@ -0,0 +1,58 @@
+package access_token
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
+)
+
+var _ ephemeral.EphemeralResource = &AccessTokenEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithConfigure = &AccessTokenEphemeralResource{}
+
+func NewAccessTokenEphemeralResource() ephemeral.EphemeralResource {
+	return &AccessTokenEphemeralResource{}
+}
+
+type AccessTokenEphemeralResource struct {
+	portClient *cli.PortClient
+}
+
+func (r *AccessTokenEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_access_token"
+}
+
+func (r *AccessTokenEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.portClient = req.ProviderData.(*cli.PortClient)
+}
+
+func (r *AccessTokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data AccessTokenModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tokenResp, err := r.portClient.IssueAccessToken(ctx, data.ClientID.ValueString(), data.ClientSecret.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("failed to issue access token", err.Error())
+		return
+	}
+
+	data.Token = types.StringValue(tokenResp.AccessToken)
+	data.TokenType = types.StringValue(tokenResp.TokenType)
+	data.ExpiresIn = types.Int64Value(tokenResp.ExpiresIn)
+	data.ExpiresAt = types.StringNull()
+	if tokenResp.ExpiresIn > 0 {
+		data.ExpiresAt = types.StringValue(time.Now().Add(time.Duration(tokenResp.ExpiresIn) * time.Second).UTC().Format(time.RFC3339))
+	}
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
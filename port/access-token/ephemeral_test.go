@@ -0,0 +1,110 @@
+package access_token_test
+
+import (
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/acctest"
+)
+
+func protoV6ProviderFactoriesWithEcho(t *testing.T) map[string]func() (tfprotov6.ProviderServer, error) {
+	factories := map[string]func() (tfprotov6.ProviderServer, error){
+		"echo": echoprovider.NewProviderServer(),
+	}
+	for name, factory := range acctest.ProviderFactories(t) {
+		factories[name] = factory
+	}
+	return factories
+}
+
+func TestAccPortAccessTokenEphemeralResource(t *testing.T) {
+	var testAccAccessTokenConfig = `
+	ephemeral "port_access_token" "this" {}
+
+	provider "echo" {
+		data = ephemeral.port_access_token.this
+	}
+
+	resource "echo" "token" {}
+`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactoriesWithEcho(t),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig + testAccAccessTokenConfig,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("echo.token", tfjsonpath.New("data").AtMapKey("token"), knownvalue.StringRegexp(regexp.MustCompile(`.+`))),
+					statecheck.ExpectKnownValue("echo.token", tfjsonpath.New("data").AtMapKey("token_type"), knownvalue.StringExact("Bearer")),
+					statecheck.ExpectKnownValue("echo.token", tfjsonpath.New("data").AtMapKey("client_id"), knownvalue.Null()),
+				},
+			},
+		},
+	})
+}
+
+func TestAccPortAccessTokenEphemeralResourceWithCredentials(t *testing.T) {
+	var testAccAccessTokenConfig = `
+	variable "client_id" {
+		type = string
+	}
+
+	variable "client_secret" {
+		type      = string
+		sensitive = true
+	}
+
+	ephemeral "port_access_token" "this" {
+		client_id     = var.client_id
+		client_secret = var.client_secret
+	}
+
+	provider "echo" {
+		data = ephemeral.port_access_token.this
+	}
+
+	resource "echo" "token" {}
+`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactoriesWithEcho(t),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig + testAccAccessTokenConfig,
+				ConfigVariables: map[string]config.Variable{
+					"client_id":     config.StringVariable(os.Getenv("PORT_CLIENT_ID")),
+					"client_secret": config.StringVariable(os.Getenv("PORT_CLIENT_SECRET")),
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("echo.token", tfjsonpath.New("data").AtMapKey("token"), knownvalue.StringRegexp(regexp.MustCompile(`.+`))),
+					statecheck.ExpectKnownValue("echo.token", tfjsonpath.New("data").AtMapKey("expires_in"), knownvalue.NotNull()),
+				},
+			},
+			{
+				Config: acctest.ProviderConfig + `
+	ephemeral "port_access_token" "this" {
+		client_id = "only-an-id"
+	}
+`,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+		},
+	})
+}
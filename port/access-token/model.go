@@ -0,0 +1,12 @@
+package access_token
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+type AccessTokenModel struct {
+	ClientID     types.String `tfsdk:"client_id"`
+	ClientSecret types.String `tfsdk:"client_secret"`
+	Token        types.String `tfsdk:"token"`
+	TokenType    types.String `tfsdk:"token_type"`
+	ExpiresIn    types.Int64  `tfsdk:"expires_in"`
+	ExpiresAt    types.String `tfsdk:"expires_at"`
+}
@@ -0,0 +1,100 @@
+package access_token
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+func AccessTokenSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"client_id": schema.StringAttribute{
+			MarkdownDescription: "The client ID to issue the token for. Defaults to the client ID the provider is configured with",
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.AlsoRequires(path.MatchRoot("client_secret")),
+			},
+		},
+		"client_secret": schema.StringAttribute{
+			MarkdownDescription: "The client secret to issue the token with. Defaults to the secret the provider is configured with",
+			Optional:            true,
+			Sensitive:           true,
+			Validators: []validator.String{
+				stringvalidator.AlsoRequires(path.MatchRoot("client_id")),
+			},
+		},
+		"token": schema.StringAttribute{
+			MarkdownDescription: "The access token",
+			Computed:            true,
+			Sensitive:           true,
+		},
+		"token_type": schema.StringAttribute{
+			MarkdownDescription: "The type of the access token, e.g. `Bearer`",
+			Computed:            true,
+		},
+		"expires_in": schema.Int64Attribute{
+			MarkdownDescription: "The amount of seconds the access token is valid for",
+			Computed:            true,
+		},
+		"expires_at": schema.StringAttribute{
+			MarkdownDescription: "The time the access token expires at, in RFC 3339 format",
+			Computed:            true,
+		},
+	}
+}
+
+func (r *AccessTokenEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: AccessTokenEphemeralResourceMarkdownDescription,
+		Attributes:          AccessTokenSchema(),
+	}
+}
+
+var AccessTokenEphemeralResourceMarkdownDescription = `
+
+# Access Token Ephemeral Resource
+
+Issues a short-lived Port access token. The token is never stored in the plan or state, so it can be set in the configuration of other providers that call Port directly, like the ` + "`restapi`" + ` provider, or in write-only arguments, without exposing the client secret to them.
+
+Requires Terraform 1.10 or later.
+
+## Example Usage
+
+` + "```hcl" + `
+ephemeral "port_access_token" "this" {}
+
+provider "restapi" {
+  uri = "https://api.getport.io"
+  headers = {
+    Authorization = "Bearer ${ephemeral.port_access_token.this.token}"
+  }
+}
+` + "```" + `
+
+With Terraform 1.11 or later, the token can also be passed to write-only arguments, which aren't stored either:
+
+` + "```hcl" + `
+resource "helm_release" "ocean_integration" {
+  # ...
+  set_wo = [
+    {
+      name  = "port.token"
+      value = ephemeral.port_access_token.this.token
+    }
+  ]
+}
+` + "```" + `
+
+To issue a token for different credentials than the provider's:
+
+` + "```hcl" + `
+ephemeral "port_access_token" "integration" {
+  client_id     = var.integration_client_id
+  client_secret = var.integration_client_secret
+}
+` + "```" + `
+`
@@ -6,7 +6,6 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/port-labs/terraform-provider-port-labs/v2/internal/acctest"
-	"github.com/port-labs/terraform-provider-port-labs/v2/internal/utils"
 )
 
 func testAccCreateBlueprintAndActionConfig(blueprintIdentifier string, actionIdentifier string) string {
@@ -37,8 +36,8 @@ func testAccCreateBlueprintAndActionConfig(blueprintIdentifier string, actionIde
 	}`, blueprintIdentifier, actionIdentifier)
 }
 func TestAccPortActionPermissionsBasic(t *testing.T) {
-	blueprintIdentifier := utils.GenID()
-	actionIdentifier := utils.GenID()
+	blueprintIdentifier := acctest.GenID(t)
+	actionIdentifier := acctest.GenID(t)
 	var testAccActionPermissionsConfigCreate = testAccCreateBlueprintAndActionConfig(blueprintIdentifier, actionIdentifier) + `
 	resource "port_action_permissions" "create_microservice_permissions" {
 	  action_identifier = port_action.create_microservice.identifier
@@ -60,7 +59,7 @@ func TestAccPortActionPermissionsBasic(t *testing.T) {
 	}`
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccActionPermissionsConfigCreate,
@@ -81,9 +80,9 @@ func TestAccPortActionPermissionsBasic(t *testing.T) {
 }
 
 func TestAccPortActionPermissionsUpdate(t *testing.T) {
-	blueprintIdentifier := utils.GenID()
-	actionIdentifier := utils.GenID()
-	teamName := utils.GenID()
+	blueprintIdentifier := acctest.GenID(t)
+	actionIdentifier := acctest.GenID(t)
+	teamName := acctest.GenID(t)
 	var testAccActionPermissionsConfigCreate = testAccCreateBlueprintAndActionConfig(blueprintIdentifier, actionIdentifier) + `
 	resource "port_action_permissions" "create_microservice_permissions" {
 	  action_identifier = port_action.create_microservice.identifier
@@ -151,7 +150,7 @@ func TestAccPortActionPermissionsUpdate(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccActionPermissionsConfigCreate,
@@ -188,8 +187,8 @@ func TestAccPortActionPermissionsUpdate(t *testing.T) {
 }
 
 func TestAccPortActionPermissionsWithPolicy(t *testing.T) {
-	blueprintIdentifier := utils.GenID()
-	actionIdentifier := utils.GenID()
+	blueprintIdentifier := acctest.GenID(t)
+	actionIdentifier := acctest.GenID(t)
 	var testAccActionPermissionsConfigCreate = testAccCreateBlueprintAndActionConfig(blueprintIdentifier, actionIdentifier) + `
 	resource "port_action_permissions" "create_microservice_permissions" {
 	  action_identifier = port_action.create_microservice.identifier
@@ -260,7 +259,7 @@ func TestAccPortActionPermissionsWithPolicy(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccActionPermissionsConfigCreate,
@@ -296,8 +295,8 @@ func TestAccPortActionPermissionsWithPolicy(t *testing.T) {
 }
 
 func TestAccPortActionPermissionsWithPolicyUpdate(t *testing.T) {
-	blueprintIdentifier := utils.GenID()
-	actionIdentifier := utils.GenID()
+	blueprintIdentifier := acctest.GenID(t)
+	actionIdentifier := acctest.GenID(t)
 	var testAccActionPermissionsConfigCreate = testAccCreateBlueprintAndActionConfig(blueprintIdentifier, actionIdentifier) + `
 	resource "port_action_permissions" "create_microservice_permissions" {
 	  action_identifier = port_action.create_microservice.identifier
@@ -395,7 +394,7 @@ func TestAccPortActionPermissionsWithPolicyUpdate(t *testing.T) {
 	}`
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccActionPermissionsConfigCreate,
@@ -432,8 +431,8 @@ func TestAccPortActionPermissionsWithPolicyUpdate(t *testing.T) {
 }
 
 func TestAccPortActionPermissionsImportState(t *testing.T) {
-	blueprintIdentifier := utils.GenID()
-	actionIdentifier := utils.GenID()
+	blueprintIdentifier := acctest.GenID(t)
+	actionIdentifier := acctest.GenID(t)
 	var testAccActionPermissionsConfigCreate = testAccCreateBlueprintAndActionConfig(blueprintIdentifier, actionIdentifier) + `
 	resource "port_action_permissions" "create_microservice_permissions" {
 	  action_identifier = port_action.create_microservice.identifier
@@ -482,7 +481,7 @@ func TestAccPortActionPermissionsImportState(t *testing.T) {
 	}`
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccActionPermissionsConfigCreate,
@@ -509,8 +508,8 @@ func TestAccPortActionPermissionsImportState(t *testing.T) {
 }
 
 func TestAccPortActionWithEmptyFieldsExpectDefaultsToApply(t *testing.T) {
-	blueprintIdentifier := utils.GenID()
-	actionIdentifier := utils.GenID()
+	blueprintIdentifier := acctest.GenID(t)
+	actionIdentifier := acctest.GenID(t)
 	var testAccActionPermissionsConfigCreate = testAccCreateBlueprintAndActionConfig(blueprintIdentifier, actionIdentifier) + `
 	resource "port_action_permissions" "create_microservice_permissions" {
 	  action_identifier = port_action.create_microservice.identifier
@@ -522,7 +521,7 @@ func TestAccPortActionWithEmptyFieldsExpectDefaultsToApply(t *testing.T) {
 `
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccActionPermissionsConfigCreate,
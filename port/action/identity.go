@@ -0,0 +1,43 @@
+package action
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.ResourceWithIdentity = &ActionResource{}
+
+type ActionIdentityModel struct {
+	Identifier types.String `tfsdk:"identifier"`
+}
+
+func (r *ActionResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"identifier": identityschema.StringAttribute{
+				Description:       "The identifier of the action",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func actionIdentity(identifier string) *ActionIdentityModel {
+	return &ActionIdentityModel{Identifier: types.StringValue(identifier)}
+}
+
+// importStateFromIdentity imports an action by the identity Terraform passes when the import block sets `identity`
+// instead of `id`, e.g. in the configuration generated by `terraform query`.
+func importStateFromIdentity(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var identity *ActionIdentityModel
+	resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("identifier"), identity.Identifier)...)
+}
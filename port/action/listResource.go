@@ -0,0 +1,78 @@
+package action
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
+)
+
+var _ list.ListResourceWithConfigure = &ActionListResource{}
+
+func NewActionListResource() list.ListResource {
+	return &ActionListResource{}
+}
+
+// ActionListResource lists the actions of the organization for `terraform query`.
+type ActionListResource struct {
+	portClient *cli.PortClient
+}
+
+func (r *ActionListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_action"
+}
+
+func (r *ActionListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.portClient = req.ProviderData.(*cli.PortClient)
+}
+
+func (r *ActionListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the actions of the organization",
+	}
+}
+
+func (r *ActionListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	actions, err := r.portClient.ReadActions(ctx)
+	if err != nil {
+		var diags diag.Diagnostics
+		diags.AddError("failed to read actions", err.Error())
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+	if req.Limit > 0 && int64(len(actions)) > req.Limit {
+		actions = actions[:req.Limit]
+	}
+
+	actionResource := &ActionResource{portClient: r.portClient}
+	stream.Results = func(push func(list.ListResult) bool) {
+		for _, a := range actions {
+			result := req.NewListResult(ctx)
+			result.DisplayName = a.Identifier
+			if a.Title != nil {
+				result.DisplayName = *a.Title
+			}
+			result.Diagnostics.Append(result.Identity.Set(ctx, actionIdentity(a.Identifier))...)
+
+			if req.IncludeResource && !result.Diagnostics.HasError() {
+				state := &ActionModel{}
+				if err := actionResource.refreshActionState(ctx, state, &a); err != nil {
+					result.Diagnostics.AddError("failed writing action fields to resource", err.Error())
+				} else {
+					result.Diagnostics.Append(result.Resource.Set(ctx, state)...)
+				}
+			}
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
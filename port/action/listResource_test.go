@@ -0,0 +1,53 @@
+package action_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/acctest"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/acctest/fakeclient"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPortActionList(t *testing.T) {
+	ctx := context.Background()
+	server, client := fakeclient.New(t)
+	_, err := client.CreateBlueprint(ctx, &cli.Blueprint{Identifier: "microservice", Title: "Microservice"}, nil)
+	require.NoError(t, err)
+	for _, a := range []cli.Action{
+		{Identifier: "deploy", Title: utils.PtrTo("Deploy")},
+		{Identifier: "rollback"},
+	} {
+		a.Trigger = &cli.Trigger{
+			Type:                "self-service",
+			BlueprintIdentifier: utils.PtrTo("microservice"),
+			Operation:           utils.PtrTo("DAY-2"),
+			UserInputs:          &cli.ActionUserInputs{Properties: map[string]cli.ActionProperty{}},
+		}
+		a.InvocationMethod = &cli.InvocationMethod{Type: "WEBHOOK", Url: utils.PtrTo("https://example.com")}
+		_, err = client.CreateAction(ctx, &a)
+		require.NoError(t, err)
+	}
+
+	results := acctest.List(t, server, "port_action", nil, false)
+	require.Len(t, results, 2)
+	assert.Equal(t, "Deploy", results[0].DisplayName)
+	assert.Equal(t, "rollback", results[1].DisplayName, "actions without a title are listed by their identifier")
+	assert.Nil(t, results[0].Resource)
+
+	results = acctest.List(t, server, "port_action", nil, true)
+	require.Len(t, results, 2)
+	assert.Equal(t, tftypes.NewValue(tftypes.String, "deploy"), results[0].Identity["identifier"])
+	assert.Equal(t, tftypes.NewValue(tftypes.String, "Deploy"), results[0].Resource["title"])
+
+	imported := acctest.ImportFromIdentity(t, server, "port_action", results[0].Identity)
+	assert.Equal(t, results[0].Resource["identifier"], imported["identifier"])
+	assert.Equal(t, results[0].Resource["title"], imported["title"])
+	assert.False(t, imported["self_service_trigger"].IsNull())
+	assert.Equal(t, results[0].Resource["self_service_trigger"], imported["self_service_trigger"])
+	assert.Equal(t, results[0].Resource["webhook_method"], imported["webhook_method"])
+}
@@ -23,6 +23,8 @@ type ActionResource struct {
 
 func (r *ActionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_action"
+	// Actions are renamed in place, so their identity can change.
+	resp.ResourceBehavior.MutableIdentity = true
 }
 
 func (r *ActionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -34,6 +36,11 @@ func (r *ActionResource) Configure(ctx context.Context, req resource.ConfigureRe
 }
 
 func (r *ActionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		importStateFromIdentity(ctx, req, resp)
+		return
+	}
+
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("identifier"), req.ID)...)
 }
 
@@ -52,6 +59,7 @@ func (r *ActionResource) Read(ctx context.Context, req resource.ReadRequest, res
 		actionIdentifier = fmt.Sprintf("%s_%s", blueprintIdentifier, actionIdentifier)
 	}
 
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, actionIdentity(actionIdentifier))...)
 	a, statusCode, err := r.portClient.ReadAction(ctx, actionIdentifier)
 	if err != nil {
 		if statusCode == 404 {
@@ -118,6 +126,7 @@ func (r *ActionResource) Create(ctx context.Context, req resource.CreateRequest,
 	state.Identifier = types.StringValue(a.Identifier)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, actionIdentity(a.Identifier))...)
 }
 
 func (r *ActionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -158,5 +167,6 @@ func (r *ActionResource) Update(ctx context.Context, req resource.UpdateRequest,
 	state.Identifier = types.StringValue(a.Identifier)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, actionIdentity(a.Identifier))...)
 
 }
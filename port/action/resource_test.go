@@ -7,7 +7,6 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/port-labs/terraform-provider-port-labs/v2/internal/acctest"
-	"github.com/port-labs/terraform-provider-port-labs/v2/internal/utils"
 )
 
 func testAccCreateBlueprintConfig(identifier string) string {
@@ -29,8 +28,8 @@ func testAccCreateBlueprintConfig(identifier string) string {
 	`, identifier)
 }
 func TestAccPortActionBasic(t *testing.T) {
-	identifier := utils.GenID()
-	actionIdentifier := utils.GenID()
+	identifier := acctest.GenID(t)
+	actionIdentifier := acctest.GenID(t)
 	var testAccActionConfigCreate = testAccCreateBlueprintConfig(identifier) + fmt.Sprintf(`
 	resource "port_action" "create_microservice" {
 		title = "TF Provider Test"
@@ -44,7 +43,7 @@ func TestAccPortActionBasic(t *testing.T) {
 	}`, actionIdentifier)
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: acctest.ProviderConfig + testAccActionConfigCreate,
@@ -61,8 +60,8 @@ func TestAccPortActionBasic(t *testing.T) {
 }
 
 func TestAccPortAction(t *testing.T) {
-	identifier := utils.GenID()
-	actionIdentifier := utils.GenID()
+	identifier := acctest.GenID(t)
+	actionIdentifier := acctest.GenID(t)
 	var testAccActionConfigCreate = testAccCreateBlueprintConfig(identifier) + fmt.Sprintf(`
 	resource "port_action" "create_microservice" {
 		title = "TF Provider Test"
@@ -128,7 +127,7 @@ func TestAccPortAction(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: acctest.ProviderConfig + testAccActionConfigCreate,
@@ -165,8 +164,8 @@ func TestAccPortAction(t *testing.T) {
 }
 
 func TestAccPortActionKafkaInvocation(t *testing.T) {
-	identifier := utils.GenID()
-	actionIdentifier := utils.GenID()
+	identifier := acctest.GenID(t)
+	actionIdentifier := acctest.GenID(t)
 	var testAccActionConfigCreate = testAccCreateBlueprintConfig(identifier) + fmt.Sprintf(`
 	resource "port_action" "create_microservice" {
 		title = "TF Provider Test"
@@ -185,7 +184,7 @@ func TestAccPortActionKafkaInvocation(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: acctest.ProviderConfig + testAccActionConfigCreate,
@@ -203,8 +202,8 @@ func TestAccPortActionKafkaInvocation(t *testing.T) {
 }
 
 func TestAccPortActionWebhookInvocation(t *testing.T) {
-	identifier := utils.GenID()
-	actionIdentifier := utils.GenID()
+	identifier := acctest.GenID(t)
+	actionIdentifier := acctest.GenID(t)
 	var testAccActionConfigCreate = testAccCreateBlueprintConfig(identifier) + fmt.Sprintf(`
 	resource "port_action" "create_microservice" {
 		title = "TF Provider Test"
@@ -226,7 +225,7 @@ func TestAccPortActionWebhookInvocation(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: acctest.ProviderConfig + testAccActionConfigCreate,
@@ -248,8 +247,8 @@ func TestAccPortActionWebhookInvocation(t *testing.T) {
 	})
 }
 func TestAccPortActionWebhookSyncInvocation(t *testing.T) {
-	identifier := utils.GenID()
-	actionIdentifier := utils.GenID()
+	identifier := acctest.GenID(t)
+	actionIdentifier := acctest.GenID(t)
 	var testAccActionConfigCreate = testAccCreateBlueprintConfig(identifier) + fmt.Sprintf(`
 	resource "port_action" "create_microservice" {
 		title = "TF Provider Test"
@@ -271,7 +270,7 @@ func TestAccPortActionWebhookSyncInvocation(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: acctest.ProviderConfig + testAccActionConfigCreate,
@@ -294,8 +293,8 @@ func TestAccPortActionWebhookSyncInvocation(t *testing.T) {
 }
 
 func TestAccPortActionGithubInvocation(t *testing.T) {
-	identifier := utils.GenID()
-	actionIdentifier := utils.GenID()
+	identifier := acctest.GenID(t)
+	actionIdentifier := acctest.GenID(t)
 	var testAccActionConfigCreate = testAccCreateBlueprintConfig(identifier) + fmt.Sprintf(`
 	resource "port_action" "create_microservice" {
 		title = "TF Provider Test"
@@ -316,7 +315,7 @@ func TestAccPortActionGithubInvocation(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: acctest.ProviderConfig + testAccActionConfigCreate,
@@ -338,8 +337,8 @@ func TestAccPortActionGithubInvocation(t *testing.T) {
 }
 
 func TestAccPortActionGitlabInvocation(t *testing.T) {
-	identifier := utils.GenID()
-	actionIdentifier := utils.GenID()
+	identifier := acctest.GenID(t)
+	actionIdentifier := acctest.GenID(t)
 	var testAccActionConfigCreate = testAccCreateBlueprintConfig(identifier) + fmt.Sprintf(`
 	resource "port_action" "create_microservice" {
 		title = "TF Provider Test"
@@ -359,7 +358,7 @@ func TestAccPortActionGitlabInvocation(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: acctest.ProviderConfig + testAccActionConfigCreate,
@@ -379,8 +378,8 @@ func TestAccPortActionGitlabInvocation(t *testing.T) {
 	})
 }
 func TestAccPortActionAzureInvocation(t *testing.T) {
-	identifier := utils.GenID()
-	actionIdentifier := utils.GenID()
+	identifier := acctest.GenID(t)
+	actionIdentifier := acctest.GenID(t)
 	var testAccActionConfigCreate = testAccCreateBlueprintConfig(identifier) + fmt.Sprintf(`
 	resource "port_action" "create_microservice" {
 		title = "TF Provider Test"
@@ -399,7 +398,7 @@ func TestAccPortActionAzureInvocation(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: acctest.ProviderConfig + testAccActionConfigCreate,
@@ -419,8 +418,8 @@ func TestAccPortActionAzureInvocation(t *testing.T) {
 }
 
 func TestAccPortActionUpsertEntityInvocation(t *testing.T) {
-	identifier := utils.GenID()
-	actionIdentifier := utils.GenID()
+	identifier := acctest.GenID(t)
+	actionIdentifier := acctest.GenID(t)
 	var testAccActionConfigCreate = testAccCreateBlueprintConfig(identifier) + fmt.Sprintf(`
 	resource "port_action" "create_microservice" {
 		title = "TF Provider Test"
@@ -445,7 +444,7 @@ func TestAccPortActionUpsertEntityInvocation(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: acctest.ProviderConfig + testAccActionConfigCreate,
@@ -469,8 +468,8 @@ func TestAccPortActionUpsertEntityInvocation(t *testing.T) {
 }
 
 func TestAccPortActionUpsertEntityInvocationWithJqTeams(t *testing.T) {
-	identifier := utils.GenID()
-	actionIdentifier := utils.GenID()
+	identifier := acctest.GenID(t)
+	actionIdentifier := acctest.GenID(t)
 	var testAccActionConfigCreate = testAccCreateBlueprintConfig(identifier) + fmt.Sprintf(`
 	resource "port_action" "create_microservice" {
 		title = "TF Provider Test"
@@ -495,7 +494,7 @@ func TestAccPortActionUpsertEntityInvocationWithJqTeams(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: acctest.ProviderConfig + testAccActionConfigCreate,
@@ -519,8 +518,8 @@ func TestAccPortActionUpsertEntityInvocationWithJqTeams(t *testing.T) {
 }
 
 func TestAccPortActionImport(t *testing.T) {
-	blueprintIdentifier := utils.GenID()
-	actionIdentifier := utils.GenID()
+	blueprintIdentifier := acctest.GenID(t)
+	actionIdentifier := acctest.GenID(t)
 	var testAccActionConfigCreate = testAccCreateBlueprintConfig(blueprintIdentifier) + fmt.Sprintf(`
 	resource "port_action" "create_microservice" {
 		title = "TF Provider Test"
@@ -545,7 +544,7 @@ func TestAccPortActionImport(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 
 		Steps: []resource.TestStep{
 			{
@@ -573,8 +572,8 @@ func TestAccPortActionImport(t *testing.T) {
 }
 
 func TestPortActionFalseRequiredPropShouldNotWork(t *testing.T) {
-	blueprintIdentifier := utils.GenID()
-	actionIdentifier := utils.GenID()
+	blueprintIdentifier := acctest.GenID(t)
+	actionIdentifier := acctest.GenID(t)
 	var testAccActionConfigCreate = testAccCreateBlueprintConfig(blueprintIdentifier) + fmt.Sprintf(`
 	resource "port_action" "create_microservice" {
 		title = "TF Provider Test"
@@ -599,7 +598,7 @@ func TestPortActionFalseRequiredPropShouldNotWork(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 
 		Steps: []resource.TestStep{
 			{
@@ -611,8 +610,8 @@ func TestPortActionFalseRequiredPropShouldNotWork(t *testing.T) {
 }
 
 func TestAccPortActionUpdate(t *testing.T) {
-	identifier := utils.GenID()
-	actionIdentifier := utils.GenID()
+	identifier := acctest.GenID(t)
+	actionIdentifier := acctest.GenID(t)
 	var testAccActionConfigCreate = testAccCreateBlueprintConfig(identifier) + fmt.Sprintf(`
 	resource "port_action" "create_microservice" {
 		title = "TF Provider Test"
@@ -658,7 +657,7 @@ func TestAccPortActionUpdate(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 
 		Steps: []resource.TestStep{
 			{
@@ -692,8 +691,8 @@ func TestAccPortActionUpdate(t *testing.T) {
 }
 
 func TestAccPortActionArrayPropsWithMaxItemsJqQuery(t *testing.T) {
-	identifier := utils.GenID()
-	actionIdentifier := utils.GenID()
+	identifier := acctest.GenID(t)
+	actionIdentifier := acctest.GenID(t)
 	var testAccActionConfigCreate = testAccCreateBlueprintConfig(identifier) + fmt.Sprintf(`
 	resource "port_action" "create_microservice" {
 		title = "TF Provider Test - Max Items JQ Query"
@@ -721,7 +720,7 @@ func TestAccPortActionArrayPropsWithMaxItemsJqQuery(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: acctest.ProviderConfig + testAccActionConfigCreate,
@@ -738,8 +737,8 @@ func TestAccPortActionArrayPropsWithMaxItemsJqQuery(t *testing.T) {
 }
 
 func TestAccPortActionArrayPropsWithMinItemsJqQuery(t *testing.T) {
-	identifier := utils.GenID()
-	actionIdentifier := utils.GenID()
+	identifier := acctest.GenID(t)
+	actionIdentifier := acctest.GenID(t)
 	var testAccActionConfigCreate = testAccCreateBlueprintConfig(identifier) + fmt.Sprintf(`
 	resource "port_action" "create_microservice" {
 		title = "TF Provider Test - Min Items JQ Query"
@@ -765,7 +764,7 @@ func TestAccPortActionArrayPropsWithMinItemsJqQuery(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: acctest.ProviderConfig + testAccActionConfigCreate,
@@ -781,8 +780,8 @@ func TestAccPortActionArrayPropsWithMinItemsJqQuery(t *testing.T) {
 }
 
 func TestAccPortActionArrayPropsWithBothJqQueries(t *testing.T) {
-	identifier := utils.GenID()
-	actionIdentifier := utils.GenID()
+	identifier := acctest.GenID(t)
+	actionIdentifier := acctest.GenID(t)
 	var testAccActionConfigCreate = testAccCreateBlueprintConfig(identifier) + fmt.Sprintf(`
 	resource "port_action" "create_microservice" {
 		title = "TF Provider Test - Both JQ Queries"
@@ -817,7 +816,7 @@ func TestAccPortActionArrayPropsWithBothJqQueries(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: acctest.ProviderConfig + testAccActionConfigCreate,
@@ -835,8 +834,8 @@ func TestAccPortActionArrayPropsWithBothJqQueries(t *testing.T) {
 }
 
 func TestAccPortActionArrayPropsWithStaticMaxMinItems(t *testing.T) {
-	identifier := utils.GenID()
-	actionIdentifier := utils.GenID()
+	identifier := acctest.GenID(t)
+	actionIdentifier := acctest.GenID(t)
 	var testAccActionConfigCreate = testAccCreateBlueprintConfig(identifier) + fmt.Sprintf(`
 	resource "port_action" "create_microservice" {
 		title = "TF Provider Test - Static Max Min Items"
@@ -863,7 +862,7 @@ func TestAccPortActionArrayPropsWithStaticMaxMinItems(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: acctest.ProviderConfig + testAccActionConfigCreate,
@@ -880,8 +879,8 @@ func TestAccPortActionArrayPropsWithStaticMaxMinItems(t *testing.T) {
 }
 
 func TestAccPortActionArrayPropsConflictMaxItemsAndJqQuery(t *testing.T) {
-	identifier := utils.GenID()
-	actionIdentifier := utils.GenID()
+	identifier := acctest.GenID(t)
+	actionIdentifier := acctest.GenID(t)
 	var testAccActionConfigCreate = testAccCreateBlueprintConfig(identifier) + fmt.Sprintf(`
 	resource "port_action" "create_microservice" {
 		title = "TF Provider Test - Conflict Test"
@@ -910,7 +909,7 @@ func TestAccPortActionArrayPropsConflictMaxItemsAndJqQuery(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config:      acctest.ProviderConfig + testAccActionConfigCreate,
@@ -921,8 +920,8 @@ func TestAccPortActionArrayPropsConflictMaxItemsAndJqQuery(t *testing.T) {
 }
 
 func TestAccPortActionArrayPropsConflictMinItemsAndJqQuery(t *testing.T) {
-	identifier := utils.GenID()
-	actionIdentifier := utils.GenID()
+	identifier := acctest.GenID(t)
+	actionIdentifier := acctest.GenID(t)
 	var testAccActionConfigCreate = testAccCreateBlueprintConfig(identifier) + fmt.Sprintf(`
 	resource "port_action" "create_microservice" {
 		title = "TF Provider Test - Conflict Test"
@@ -949,7 +948,7 @@ func TestAccPortActionArrayPropsConflictMinItemsAndJqQuery(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config:      acctest.ProviderConfig + testAccActionConfigCreate,
@@ -960,8 +959,8 @@ func TestAccPortActionArrayPropsConflictMinItemsAndJqQuery(t *testing.T) {
 }
 
 func TestAccPortActionArrayPropsUpdateFromStaticToJqQuery(t *testing.T) {
-	identifier := utils.GenID()
-	actionIdentifier := utils.GenID()
+	identifier := acctest.GenID(t)
+	actionIdentifier := acctest.GenID(t)
 	var testAccActionConfigCreate = testAccCreateBlueprintConfig(identifier) + fmt.Sprintf(`
 	resource "port_action" "create_microservice" {
 		title = "TF Provider Test - Update Test"
@@ -1014,7 +1013,7 @@ func TestAccPortActionArrayPropsUpdateFromStaticToJqQuery(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: acctest.ProviderConfig + testAccActionConfigCreate,
@@ -1035,8 +1034,8 @@ func TestAccPortActionArrayPropsUpdateFromStaticToJqQuery(t *testing.T) {
 }
 
 func TestAccPortActionAdvancedFormConfigurations(t *testing.T) {
-	identifier := utils.GenID()
-	actionIdentifier := utils.GenID()
+	identifier := acctest.GenID(t)
+	actionIdentifier := acctest.GenID(t)
 	var testAccActionConfigCreate = testAccCreateBlueprintConfig(identifier) + fmt.Sprintf(`
 	resource "port_action" "action1" {
 		title             = "Action 1"
@@ -1108,7 +1107,7 @@ func TestAccPortActionAdvancedFormConfigurations(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 
 		Steps: []resource.TestStep{
 			{
@@ -1144,8 +1143,8 @@ func TestAccPortActionAdvancedFormConfigurations(t *testing.T) {
 
 // TestAccPortActionNestedDatasetRules tests nested dataset rules with combinator groups
 func TestAccPortActionNestedDatasetRules(t *testing.T) {
-	identifier := utils.GenID()
-	actionIdentifier := utils.GenID()
+	identifier := acctest.GenID(t)
+	actionIdentifier := acctest.GenID(t)
 	var testAccActionConfigCreate = testAccCreateBlueprintConfig(identifier) + fmt.Sprintf(`
 	resource "port_action" "nested_dataset" {
 		title             = "Nested Dataset Test"
@@ -1200,7 +1199,7 @@ func TestAccPortActionNestedDatasetRules(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 
 		Steps: []resource.TestStep{
 			{
@@ -1230,8 +1229,8 @@ func TestAccPortActionNestedDatasetRules(t *testing.T) {
 }
 
 func TestAccPortActionJqDefault(t *testing.T) {
-	identifier := utils.GenID()
-	actionIdentifier := utils.GenID()
+	identifier := acctest.GenID(t)
+	actionIdentifier := acctest.GenID(t)
 	var testAccActionConfigCreate = testAccCreateBlueprintConfig(identifier) + fmt.Sprintf(`
 	resource "port_action" "create_microservice" {
 		title             = "Action 1"
@@ -1278,7 +1277,7 @@ func TestAccPortActionJqDefault(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 
 		Steps: []resource.TestStep{
 			{
@@ -1306,8 +1305,8 @@ func TestAccPortActionJqDefault(t *testing.T) {
 }
 
 func TestAccPortActionEnumJqQuery(t *testing.T) {
-	identifier := utils.GenID()
-	actionIdentifier := utils.GenID()
+	identifier := acctest.GenID(t)
+	actionIdentifier := acctest.GenID(t)
 	var testAccActionConfigCreate = testAccCreateBlueprintConfig(identifier) + fmt.Sprintf(`
 	resource "port_action" "create_microservice" {
 		title             = "Action 1"
@@ -1351,7 +1350,7 @@ func TestAccPortActionEnumJqQuery(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 
 		Steps: []resource.TestStep{
 			{
@@ -1376,8 +1375,8 @@ func TestAccPortActionEnumJqQuery(t *testing.T) {
 }
 
 func TestAccPortActionEnum(t *testing.T) {
-	identifier := utils.GenID()
-	actionIdentifier := utils.GenID()
+	identifier := acctest.GenID(t)
+	actionIdentifier := acctest.GenID(t)
 	var testAccActionConfigCreate = testAccCreateBlueprintConfig(identifier) + fmt.Sprintf(`
 	resource "port_action" "create_microservice" {
 		title             = "Action 1"
@@ -1414,7 +1413,7 @@ func TestAccPortActionEnum(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 
 		Steps: []resource.TestStep{
 			{
@@ -1437,8 +1436,8 @@ func TestAccPortActionEnum(t *testing.T) {
 }
 
 func TestAccPortActionImportNonJqFields(t *testing.T) {
-	blueprintIdentifier := utils.GenID()
-	actionIdentifier := utils.GenID()
+	blueprintIdentifier := acctest.GenID(t)
+	actionIdentifier := acctest.GenID(t)
 	var testAccActionConfig = testAccCreateBlueprintConfig(blueprintIdentifier) + fmt.Sprintf(`
 	resource "port_action" "create_microservice" {
 		title      = "TF Provider Test - Import Non-JQ Fields"
@@ -1522,7 +1521,7 @@ func TestAccPortActionImportNonJqFields(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: acctest.ProviderConfig + testAccActionConfig,
@@ -1613,8 +1612,8 @@ func TestAccPortActionImportNonJqFields(t *testing.T) {
 }
 
 func TestAccPortActionPatternJqQuery(t *testing.T) {
-	identifier := utils.GenID()
-	actionIdentifier := utils.GenID()
+	identifier := acctest.GenID(t)
+	actionIdentifier := acctest.GenID(t)
 	var testAccActionConfigCreate = testAccCreateBlueprintConfig(identifier) + fmt.Sprintf(`
 	resource "port_action" "create_microservice" {
 		title             = "Action 1"
@@ -1637,7 +1636,7 @@ func TestAccPortActionPatternJqQuery(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 
 		Steps: []resource.TestStep{
 			{
@@ -1655,8 +1654,8 @@ func TestAccPortActionPatternJqQuery(t *testing.T) {
 	})
 }
 func TestAccPortActionPattern(t *testing.T) {
-	identifier := utils.GenID()
-	actionIdentifier := utils.GenID()
+	identifier := acctest.GenID(t)
+	actionIdentifier := acctest.GenID(t)
 	var testAccActionConfigCreate = testAccCreateBlueprintConfig(identifier) + fmt.Sprintf(`
 	resource "port_action" "create_microservice" {
 		title             = "Action 1"
@@ -1679,7 +1678,7 @@ func TestAccPortActionPattern(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 
 		Steps: []resource.TestStep{
 			{
@@ -1699,8 +1698,8 @@ func TestAccPortActionPattern(t *testing.T) {
 
 // TestAccPortActionPatternConflict tests that pattern and pattern_jq_query can't be used simultaneously
 func TestAccPortActionPatternConflict(t *testing.T) {
-	identifier := utils.GenID()
-	actionIdentifier := utils.GenID()
+	identifier := acctest.GenID(t)
+	actionIdentifier := acctest.GenID(t)
 	var testAccActionConfigCreate = testAccCreateBlueprintConfig(identifier) + fmt.Sprintf(`
 	resource "port_action" "create_microservice" {
 		title             = "Action 1"
@@ -1724,7 +1723,7 @@ func TestAccPortActionPatternConflict(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 
 		Steps: []resource.TestStep{
 			{
@@ -1737,8 +1736,8 @@ func TestAccPortActionPatternConflict(t *testing.T) {
 
 // tests that empty pattern_jq_query values are rejected
 func TestAccPortActionEmptyPatternJqQuery(t *testing.T) {
-	identifier := utils.GenID()
-	actionIdentifier := utils.GenID()
+	identifier := acctest.GenID(t)
+	actionIdentifier := acctest.GenID(t)
 	var testAccActionConfigCreate = testAccCreateBlueprintConfig(identifier) + fmt.Sprintf(`
 	resource "port_action" "create_microservice" {
 		title             = "Action 1"
@@ -1761,7 +1760,7 @@ func TestAccPortActionEmptyPatternJqQuery(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 
 		Steps: []resource.TestStep{
 			{
@@ -1774,8 +1773,8 @@ func TestAccPortActionEmptyPatternJqQuery(t *testing.T) {
 
 // tests complex JQ expressions for pattern_jq_query
 func TestAccPortActionPatternJqQueryComplex(t *testing.T) {
-	identifier := utils.GenID()
-	actionIdentifier := utils.GenID()
+	identifier := acctest.GenID(t)
+	actionIdentifier := acctest.GenID(t)
 
 	// Complex JQ Query
 	var testAccActionConfigComplex = testAccCreateBlueprintConfig(identifier) + fmt.Sprintf(`
@@ -1800,7 +1799,7 @@ func TestAccPortActionPatternJqQueryComplex(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 
 		Steps: []resource.TestStep{
 			{
@@ -1815,8 +1814,8 @@ func TestAccPortActionPatternJqQueryComplex(t *testing.T) {
 
 // tests using pattern_jq_query to generate a list of allowed values
 func TestAccPortActionPatternJqQueryAllowedValues(t *testing.T) {
-	identifier := utils.GenID()
-	actionIdentifier := utils.GenID()
+	identifier := acctest.GenID(t)
+	actionIdentifier := acctest.GenID(t)
 	var testAccActionConfigCreate = testAccCreateBlueprintConfig(identifier) + fmt.Sprintf(`
 	resource "port_action" "create_microservice" {
 		title             = "Action 1"
@@ -1840,7 +1839,7 @@ func TestAccPortActionPatternJqQueryAllowedValues(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 
 		Steps: []resource.TestStep{
 			{
@@ -1856,8 +1855,8 @@ func TestAccPortActionPatternJqQueryAllowedValues(t *testing.T) {
 
 // tests using pattern_jq_query with a direct JSON array format
 func TestAccPortActionPatternJqQueryDirectArray(t *testing.T) {
-	identifier := utils.GenID()
-	actionIdentifier := utils.GenID()
+	identifier := acctest.GenID(t)
+	actionIdentifier := acctest.GenID(t)
 	var testAccActionConfigCreate = testAccCreateBlueprintConfig(identifier) + fmt.Sprintf(`
 	resource "port_action" "create_microservice" {
 		title             = "Action 1"
@@ -1881,7 +1880,7 @@ func TestAccPortActionPatternJqQueryDirectArray(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 
 		Steps: []resource.TestStep{
 			{
@@ -1900,8 +1899,8 @@ func TestAccPortActionPatternJqQueryDirectArray(t *testing.T) {
 }
 
 func TestAccPortActionOrderProperties(t *testing.T) {
-	identifier := utils.GenID()
-	actionIdentifier := utils.GenID()
+	identifier := acctest.GenID(t)
+	actionIdentifier := acctest.GenID(t)
 	var testAccActionConfigCreate = testAccCreateBlueprintConfig(identifier) + fmt.Sprintf(`
 	resource "port_action" "action1" {
 		title = "Action 1"
@@ -1927,7 +1926,7 @@ func TestAccPortActionOrderProperties(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 
 		Steps: []resource.TestStep{
 			{
@@ -1947,8 +1946,8 @@ func TestAccPortActionOrderProperties(t *testing.T) {
 }
 
 func TestAccPortActionEncryption(t *testing.T) {
-	blueprintIdentifier := utils.GenID()
-	actionIdentifier := utils.GenID()
+	blueprintIdentifier := acctest.GenID(t)
+	actionIdentifier := acctest.GenID(t)
 	var testAccActionConfigCreate = testAccCreateBlueprintConfig(blueprintIdentifier) + fmt.Sprintf(`
 	resource "port_action" "action1" {
 		title = "TF Provider Test"
@@ -1981,7 +1980,7 @@ func TestAccPortActionEncryption(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 
 		Steps: []resource.TestStep{
 			{
@@ -2006,8 +2005,8 @@ func TestAccPortActionEncryption(t *testing.T) {
 }
 
 func TestAccPortActionClientSideEncryption(t *testing.T) {
-	blueprintIdentifier := utils.GenID()
-	actionIdentifier := utils.GenID()
+	blueprintIdentifier := acctest.GenID(t)
+	actionIdentifier := acctest.GenID(t)
 	testPublicKey := `-----BEGIN PUBLIC KEY-----
 MIIBIjANBgkqhkiG9w0BAQEFAAOCAQ8AMIIBCgKCAQEA0Z3VS5JJcds3xfn/ygWyf8sK8pPgbJPbL3pvFkOk9vXwB1QsE0p2LXRJ8ABkOC8fAKCVlCcHWoF7AXxEm+FKxqMJJO7vOxYXf4cF3bHPzR3pHJnFgAtY3aN/VBMAnTvvvfoUBGhLf0oEGoXmCQbZzP3zJIzX/O0G8u0L+wMw9e3CnGWMFYVbq3zOdmGBYVDMnR4lqJMfT3+Qr+w/F6Vf0jG3x8OXrHVCiNxNv0xHp5zRJvQMW7jDk9frYmOxFvACP/yLMDx/PA/kJxZ0IqSyhBZ0zfjA3bjZkQfT5NrJmzY5C1t5F6x4sFdHb1e5Kv5VDFMQw5tSMPNhLo3qYVVnTwIDAQAB
 -----END PUBLIC KEY-----`
@@ -2049,7 +2048,7 @@ MIIBIjANBgkqhkiG9w0BAQEFAAOCAQ8AMIIBCgKCAQEA0Z3VS5JJcds3xfn/ygWyf8sK8pPgbJPbL3pv
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 
 		Steps: []resource.TestStep{
 			{
@@ -2074,9 +2073,9 @@ MIIBIjANBgkqhkiG9w0BAQEFAAOCAQ8AMIIBCgKCAQEA0Z3VS5JJcds3xfn/ygWyf8sK8pPgbJPbL3pv
 }
 
 func TestAccPortActionUpdateIdentifier(t *testing.T) {
-	identifier := utils.GenID()
-	actionIdentifier := utils.GenID()
-	actionUpdatedIdentifier := utils.GenID()
+	identifier := acctest.GenID(t)
+	actionIdentifier := acctest.GenID(t)
+	actionUpdatedIdentifier := acctest.GenID(t)
 	var testAccActionConfigCreate = testAccCreateBlueprintConfig(identifier) + fmt.Sprintf(`
 	resource "port_action" "create_microservice" {
 		title = "TF Provider Test"
@@ -2123,7 +2122,7 @@ func TestAccPortActionUpdateIdentifier(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 
 		Steps: []resource.TestStep{
 			{
@@ -2157,8 +2156,8 @@ func TestAccPortActionUpdateIdentifier(t *testing.T) {
 }
 
 func TestAccPortActionVisibility(t *testing.T) {
-	blueprintIdentifier := utils.GenID()
-	actionIdentifier := utils.GenID()
+	blueprintIdentifier := acctest.GenID(t)
+	actionIdentifier := acctest.GenID(t)
 	var testAccActionConfigCreate = testAccCreateBlueprintConfig(blueprintIdentifier) + fmt.Sprintf(`
 	resource "port_action" "action1" {
 		title = "TF Provider Test"
@@ -2262,7 +2261,7 @@ func TestAccPortActionVisibility(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 
 		Steps: []resource.TestStep{
 			{
@@ -2297,8 +2296,8 @@ func TestAccPortActionVisibility(t *testing.T) {
 }
 
 func TestAccPortActionDisabled(t *testing.T) {
-	blueprintIdentifier := utils.GenID()
-	actionIdentifier := utils.GenID()
+	blueprintIdentifier := acctest.GenID(t)
+	actionIdentifier := acctest.GenID(t)
 	var testAccActionConfigCreate = testAccCreateBlueprintConfig(blueprintIdentifier) + fmt.Sprintf(`
 	resource "port_action" "action1" {
 		title = "TF Provider Test"
@@ -2402,7 +2401,7 @@ func TestAccPortActionDisabled(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 
 		Steps: []resource.TestStep{
 			{
@@ -2437,8 +2436,8 @@ func TestAccPortActionDisabled(t *testing.T) {
 }
 
 func TestAccPortActionRequiredConflictsWithRequiredJQ(t *testing.T) {
-	blueprintIdentifier := utils.GenID()
-	actionIdentifier := utils.GenID()
+	blueprintIdentifier := acctest.GenID(t)
+	actionIdentifier := acctest.GenID(t)
 	var testAccActionConfigCreate = testAccCreateBlueprintConfig(blueprintIdentifier) + fmt.Sprintf(`
 	resource "port_action" "action1" {
 		title = "TF Provider Test"
@@ -2520,7 +2519,7 @@ func TestAccPortActionRequiredConflictsWithRequiredJQ(t *testing.T) {
 	// expect a failure when applying the update
 	resource.Test(t, resource.TestCase{
 		PreCheck:                  func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories:  acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories:  acctest.ProviderFactories(t),
 		PreventPostDestroyRefresh: true,
 
 		Steps: []resource.TestStep{
@@ -2564,8 +2563,8 @@ func TestAccPortActionRequiredConflictsWithRequiredJQ(t *testing.T) {
 }
 
 func TestAccPortActionRequiredFalseAndNull(t *testing.T) {
-	blueprintIdentifier := utils.GenID()
-	actionIdentifier := utils.GenID()
+	blueprintIdentifier := acctest.GenID(t)
+	actionIdentifier := acctest.GenID(t)
 	var testAccActionConfigCreate = testAccCreateBlueprintConfig(blueprintIdentifier) + fmt.Sprintf(`
 	resource "port_action" "action1" {
 		title = "TF Provider Test"
@@ -2593,7 +2592,7 @@ func TestAccPortActionRequiredFalseAndNull(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                  func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories:  acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories:  acctest.ProviderFactories(t),
 		PreventPostDestroyRefresh: true,
 
 		Steps: []resource.TestStep{
@@ -2617,8 +2616,8 @@ func TestAccPortActionRequiredFalseAndNull(t *testing.T) {
 }
 
 func TestAccPortAutomationEntityCreated(t *testing.T) {
-	identifier := utils.GenID()
-	actionIdentifier := utils.GenID()
+	identifier := acctest.GenID(t)
+	actionIdentifier := acctest.GenID(t)
 	var testAccActionConfigCreate = testAccCreateBlueprintConfig(identifier) + fmt.Sprintf(`
 	resource "port_action" "create_microservice" {
 		title = "TF Provider Test"
@@ -2633,7 +2632,7 @@ func TestAccPortAutomationEntityCreated(t *testing.T) {
 	}`, actionIdentifier)
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: acctest.ProviderConfig + testAccActionConfigCreate,
@@ -2649,8 +2648,8 @@ func TestAccPortAutomationEntityCreated(t *testing.T) {
 }
 
 func TestAccPortAutomationEntityUpdated(t *testing.T) {
-	identifier := utils.GenID()
-	actionIdentifier := utils.GenID()
+	identifier := acctest.GenID(t)
+	actionIdentifier := acctest.GenID(t)
 	var testAccActionConfigCreate = testAccCreateBlueprintConfig(identifier) + fmt.Sprintf(`
 	resource "port_action" "create_microservice" {
 		title = "TF Provider Test"
@@ -2665,7 +2664,7 @@ func TestAccPortAutomationEntityUpdated(t *testing.T) {
 	}`, actionIdentifier)
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: acctest.ProviderConfig + testAccActionConfigCreate,
@@ -2681,8 +2680,8 @@ func TestAccPortAutomationEntityUpdated(t *testing.T) {
 }
 
 func TestAccPortAutomationEntityDeleted(t *testing.T) {
-	identifier := utils.GenID()
-	actionIdentifier := utils.GenID()
+	identifier := acctest.GenID(t)
+	actionIdentifier := acctest.GenID(t)
 	var testAccActionConfigCreate = testAccCreateBlueprintConfig(identifier) + fmt.Sprintf(`
 	resource "port_action" "create_microservice" {
 		title = "TF Provider Test"
@@ -2697,7 +2696,7 @@ func TestAccPortAutomationEntityDeleted(t *testing.T) {
 	}`, actionIdentifier)
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: acctest.ProviderConfig + testAccActionConfigCreate,
@@ -2713,8 +2712,8 @@ func TestAccPortAutomationEntityDeleted(t *testing.T) {
 }
 
 func TestAccPortAutomationAnyEntityChange(t *testing.T) {
-	identifier := utils.GenID()
-	actionIdentifier := utils.GenID()
+	identifier := acctest.GenID(t)
+	actionIdentifier := acctest.GenID(t)
 	var testAccActionConfigCreate = testAccCreateBlueprintConfig(identifier) + fmt.Sprintf(`
 	resource "port_action" "create_microservice" {
 		title = "TF Provider Test"
@@ -2729,7 +2728,7 @@ func TestAccPortAutomationAnyEntityChange(t *testing.T) {
 	}`, actionIdentifier)
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: acctest.ProviderConfig + testAccActionConfigCreate,
@@ -2745,8 +2744,8 @@ func TestAccPortAutomationAnyEntityChange(t *testing.T) {
 }
 
 func TestAccPortAutomationTimerPropertyExpired(t *testing.T) {
-	identifier := utils.GenID()
-	actionIdentifier := utils.GenID()
+	identifier := acctest.GenID(t)
+	actionIdentifier := acctest.GenID(t)
 	var testAccActionConfigCreate = testAccCreateBlueprintConfig(identifier) + fmt.Sprintf(`
 	resource "port_action" "create_microservice" {
 		title = "TF Provider Test"
@@ -2762,7 +2761,7 @@ func TestAccPortAutomationTimerPropertyExpired(t *testing.T) {
 	}`, actionIdentifier)
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: acctest.ProviderConfig + testAccActionConfigCreate,
@@ -2779,9 +2778,9 @@ func TestAccPortAutomationTimerPropertyExpired(t *testing.T) {
 }
 
 func TestAccPortAutomationRunCreated(t *testing.T) {
-	identifier := utils.GenID()
-	mainActionIdentifier := utils.GenID()
-	relatedActionIdentifier := utils.GenID()
+	identifier := acctest.GenID(t)
+	mainActionIdentifier := acctest.GenID(t)
+	relatedActionIdentifier := acctest.GenID(t)
 	var testAccActionConfigCreate = testAccCreateBlueprintConfig(identifier) + fmt.Sprintf(`
 	resource "port_action" "self_serve_action" {
 	title      = "self serve action"
@@ -2809,7 +2808,7 @@ func TestAccPortAutomationRunCreated(t *testing.T) {
 	}`, relatedActionIdentifier, mainActionIdentifier, relatedActionIdentifier)
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: acctest.ProviderConfig + testAccActionConfigCreate,
@@ -2831,9 +2830,9 @@ func TestAccPortAutomationRunCreated(t *testing.T) {
 }
 
 func TestAccPortAutomationRunUpdated(t *testing.T) {
-	identifier := utils.GenID()
-	mainActionIdentifier := utils.GenID()
-	relatedActionIdentifier := utils.GenID()
+	identifier := acctest.GenID(t)
+	mainActionIdentifier := acctest.GenID(t)
+	relatedActionIdentifier := acctest.GenID(t)
 	var testAccActionConfigCreate = testAccCreateBlueprintConfig(identifier) + fmt.Sprintf(`
 	resource "port_action" "self_serve_action" {
 	title      = "self serve action"
@@ -2861,7 +2860,7 @@ func TestAccPortAutomationRunUpdated(t *testing.T) {
 	}`, relatedActionIdentifier, mainActionIdentifier, relatedActionIdentifier)
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: acctest.ProviderConfig + testAccActionConfigCreate,
@@ -2883,9 +2882,9 @@ func TestAccPortAutomationRunUpdated(t *testing.T) {
 }
 
 func TestAccPortAutomationAnyRunChange(t *testing.T) {
-	identifier := utils.GenID()
-	mainActionIdentifier := utils.GenID()
-	relatedActionIdentifier := utils.GenID()
+	identifier := acctest.GenID(t)
+	mainActionIdentifier := acctest.GenID(t)
+	relatedActionIdentifier := acctest.GenID(t)
 	var testAccActionConfigCreate = testAccCreateBlueprintConfig(identifier) + fmt.Sprintf(`
 	resource "port_action" "self_serve_action" {
 	title      = "self serve action"
@@ -2913,7 +2912,7 @@ func TestAccPortAutomationAnyRunChange(t *testing.T) {
 	}`, relatedActionIdentifier, mainActionIdentifier, relatedActionIdentifier)
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: acctest.ProviderConfig + testAccActionConfigCreate,
@@ -2935,8 +2934,8 @@ func TestAccPortAutomationAnyRunChange(t *testing.T) {
 }
 
 func TestAccPortWebhookApproval(t *testing.T) {
-	identifier := utils.GenID()
-	actionIdentifier := utils.GenID()
+	identifier := acctest.GenID(t)
+	actionIdentifier := acctest.GenID(t)
 	var testAccActionConfigCreate = testAccCreateBlueprintConfig(identifier) + fmt.Sprintf(`
 	resource "port_action" "create_microservice" {
 		title = "TF Provider Test"
@@ -2955,7 +2954,7 @@ func TestAccPortWebhookApproval(t *testing.T) {
 	}`, actionIdentifier)
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: acctest.ProviderConfig + testAccActionConfigCreate,
@@ -2975,8 +2974,8 @@ func TestAccPortWebhookApproval(t *testing.T) {
 }
 
 func TestAccPortEmailApproval(t *testing.T) {
-	identifier := utils.GenID()
-	actionIdentifier := utils.GenID()
+	identifier := acctest.GenID(t)
+	actionIdentifier := acctest.GenID(t)
 	var testAccActionConfigCreate = testAccCreateBlueprintConfig(identifier) + fmt.Sprintf(`
 	resource "port_action" "create_microservice" {
 		title = "TF Provider Test"
@@ -2992,7 +2991,7 @@ func TestAccPortEmailApproval(t *testing.T) {
 	}`, actionIdentifier)
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: acctest.ProviderConfig + testAccActionConfigCreate,
@@ -3011,8 +3010,8 @@ func TestAccPortEmailApproval(t *testing.T) {
 }
 
 func TestAccPortActionStringGitlabMethodSetConditionally(t *testing.T) {
-	identifier := utils.GenID()
-	actionIdentifier := utils.GenID()
+	identifier := acctest.GenID(t)
+	actionIdentifier := acctest.GenID(t)
 	var testAccActionConfigCreate = testAccCreateBlueprintConfig(identifier) + fmt.Sprintf(`
 	resource "port_action" "action1" {
 	  title             = "Action 1"
@@ -3030,7 +3029,7 @@ func TestAccPortActionStringGitlabMethodSetConditionally(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 
 		Steps: []resource.TestStep{
 			{
@@ -3047,8 +3046,8 @@ func TestAccPortActionStringGitlabMethodSetConditionally(t *testing.T) {
 }
 
 func TestAccPortActionStringUserPropertiesConditional(t *testing.T) {
-	identifier := utils.GenID()
-	actionIdentifier := utils.GenID()
+	identifier := acctest.GenID(t)
+	actionIdentifier := acctest.GenID(t)
 	var testAccActionConfigCreate = testAccCreateBlueprintConfig(identifier) + fmt.Sprintf(`
 	resource "port_action" "action1" {
 	  title             = "Action 1"
@@ -3070,7 +3069,7 @@ func TestAccPortActionStringUserPropertiesConditional(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 
 		Steps: []resource.TestStep{
 			{
@@ -3087,8 +3086,8 @@ func TestAccPortActionStringUserPropertiesConditional(t *testing.T) {
 }
 
 func TestAccPortActionNumberUserPropertiesConditional(t *testing.T) {
-	identifier := utils.GenID()
-	actionIdentifier := utils.GenID()
+	identifier := acctest.GenID(t)
+	actionIdentifier := acctest.GenID(t)
 	var testAccActionConfigCreate = testAccCreateBlueprintConfig(identifier) + fmt.Sprintf(`
 	resource "port_action" "action1" {
 	  title             = "Action 1"
@@ -3110,7 +3109,7 @@ func TestAccPortActionNumberUserPropertiesConditional(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 
 		Steps: []resource.TestStep{
 			{
@@ -3127,8 +3126,8 @@ func TestAccPortActionNumberUserPropertiesConditional(t *testing.T) {
 }
 
 func TestAccPortActionBoolUserPropertiesConditional(t *testing.T) {
-	identifier := utils.GenID()
-	actionIdentifier := utils.GenID()
+	identifier := acctest.GenID(t)
+	actionIdentifier := acctest.GenID(t)
 	var testAccActionConfigCreate = testAccCreateBlueprintConfig(identifier) + fmt.Sprintf(`
 	resource "port_action" "action1" {
 	  title             = "Action 1"
@@ -3150,7 +3149,7 @@ func TestAccPortActionBoolUserPropertiesConditional(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 
 		Steps: []resource.TestStep{
 			{
@@ -3167,8 +3166,8 @@ func TestAccPortActionBoolUserPropertiesConditional(t *testing.T) {
 }
 
 func TestAccPortActionObjectUserPropertiesConditional(t *testing.T) {
-	identifier := utils.GenID()
-	actionIdentifier := utils.GenID()
+	identifier := acctest.GenID(t)
+	actionIdentifier := acctest.GenID(t)
 	var testAccActionConfigCreate = testAccCreateBlueprintConfig(identifier) + fmt.Sprintf(`
 	resource "port_action" "action1" {
 	  title             = "Action 1"
@@ -3190,7 +3189,7 @@ func TestAccPortActionObjectUserPropertiesConditional(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 
 		Steps: []resource.TestStep{
 			{
@@ -3207,8 +3206,8 @@ func TestAccPortActionObjectUserPropertiesConditional(t *testing.T) {
 }
 
 func TestAccPortActionArrayUserPropertiesConditional(t *testing.T) {
-	identifier := utils.GenID()
-	actionIdentifier := utils.GenID()
+	identifier := acctest.GenID(t)
+	actionIdentifier := acctest.GenID(t)
 	var testAccActionConfigCreate = testAccCreateBlueprintConfig(identifier) + fmt.Sprintf(`
 	resource "port_action" "action1" {
 	  title             = "Action 1"
@@ -3230,7 +3229,7 @@ func TestAccPortActionArrayUserPropertiesConditional(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 
 		Steps: []resource.TestStep{
 			{
@@ -3247,8 +3246,8 @@ func TestAccPortActionArrayUserPropertiesConditional(t *testing.T) {
 }
 
 func TestAccPortActionNoUserPropertiesConditional(t *testing.T) {
-	identifier := utils.GenID()
-	actionIdentifier := utils.GenID()
+	identifier := acctest.GenID(t)
+	actionIdentifier := acctest.GenID(t)
 	var testAccActionConfigCreate = testAccCreateBlueprintConfig(identifier) + fmt.Sprintf(`
 	resource "port_action" "action1" {
 	  title             = "Action 1"
@@ -3294,7 +3293,7 @@ func TestAccPortActionNoUserPropertiesConditional(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 
 		Steps: []resource.TestStep{
 			{
@@ -3315,8 +3314,8 @@ func TestAccPortActionNoUserPropertiesConditional(t *testing.T) {
 }
 
 func TestAccPortActionConditionalTrigger(t *testing.T) {
-	identifier := utils.GenID()
-	actionIdentifier := utils.GenID()
+	identifier := acctest.GenID(t)
+	actionIdentifier := acctest.GenID(t)
 	var testAccActionConfigCreate = testAccCreateBlueprintConfig(identifier) + fmt.Sprintf(`
 	resource "port_action" "create_microservice" {
 		title = "TF Provider Test"
@@ -3364,7 +3363,7 @@ func TestAccPortActionConditionalTrigger(t *testing.T) {
 	}`, actionIdentifier)
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: acctest.ProviderConfig + testAccActionConfigCreate,
@@ -3393,8 +3392,8 @@ func TestAccPortActionConditionalTrigger(t *testing.T) {
 }
 
 func TestAccPortActionUpsertEntityWithoutMappingIdentifier(t *testing.T) {
-	identifier := utils.GenID()
-	actionIdentifier := utils.GenID()
+	identifier := acctest.GenID(t)
+	actionIdentifier := acctest.GenID(t)
 	var testAccActionConfigCreate = testAccCreateBlueprintConfig(identifier) + fmt.Sprintf(`
 	resource "port_action" "create_microservice" {
 		title = "TF Provider Test"
@@ -3415,7 +3414,7 @@ func TestAccPortActionUpsertEntityWithoutMappingIdentifier(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: acctest.ProviderConfig + testAccActionConfigCreate,
@@ -3436,8 +3435,8 @@ func TestAccPortActionUpsertEntityWithoutMappingIdentifier(t *testing.T) {
 }
 
 func TestRequiredApprovalAny(t *testing.T) {
-	identifier := utils.GenID()
-	actionIdentifier := utils.GenID()
+	identifier := acctest.GenID(t)
+	actionIdentifier := acctest.GenID(t)
 	var testAccActionConfigCreate = testAccCreateBlueprintConfig(identifier) + fmt.Sprintf(`
 	resource "port_action" "create_microservice" {
 		title = "TF Provider Test"
@@ -3456,7 +3455,7 @@ func TestRequiredApprovalAny(t *testing.T) {
 	}`, actionIdentifier)
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: acctest.ProviderConfig + testAccActionConfigCreate,
@@ -3476,8 +3475,8 @@ func TestRequiredApprovalAny(t *testing.T) {
 }
 
 func TestRequiredApprovalAll(t *testing.T) {
-	identifier := utils.GenID()
-	actionIdentifier := utils.GenID()
+	identifier := acctest.GenID(t)
+	actionIdentifier := acctest.GenID(t)
 	var testAccActionConfigCreate = testAccCreateBlueprintConfig(identifier) + fmt.Sprintf(`
 	resource "port_action" "create_microservice" {
 		title = "TF Provider Test"
@@ -3496,7 +3495,7 @@ func TestRequiredApprovalAll(t *testing.T) {
 	}`, actionIdentifier)
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: acctest.ProviderConfig + testAccActionConfigCreate,
@@ -3516,8 +3515,8 @@ func TestRequiredApprovalAll(t *testing.T) {
 }
 
 func TestAccPortActionSort(t *testing.T) {
-	identifier := utils.GenID()
-	actionIdentifier := utils.GenID()
+	identifier := acctest.GenID(t)
+	actionIdentifier := acctest.GenID(t)
 	var testAccActionConfigCreate = testAccCreateBlueprintConfig(identifier) + fmt.Sprintf(`
 	resource "port_action" "create_microservice" {
 		title = "TF Provider Test"
@@ -3558,7 +3557,7 @@ func TestAccPortActionSort(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: acctest.ProviderConfig + testAccActionConfigCreate,
@@ -3585,8 +3584,8 @@ func TestAccPortActionSort(t *testing.T) {
 }
 
 func TestAccPortActionSteps(t *testing.T) {
-	identifier := utils.GenID()
-	actionIdentifier := utils.GenID()
+	identifier := acctest.GenID(t)
+	actionIdentifier := acctest.GenID(t)
 	var testAccActionConfigCreate = testAccCreateBlueprintConfig(identifier) + fmt.Sprintf(`
 	resource "port_action" "create_microservice" {
 	  title      = "TF Provider Test"
@@ -3620,7 +3619,7 @@ func TestAccPortActionSteps(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: acctest.ProviderConfig + testAccActionConfigCreate,
@@ -3642,8 +3641,8 @@ func TestAccPortActionSteps(t *testing.T) {
 }
 
 func TestAccPortActionStepsConflictWithOrder(t *testing.T) {
-	identifier := utils.GenID()
-	actionIdentifier := utils.GenID()
+	identifier := acctest.GenID(t)
+	actionIdentifier := acctest.GenID(t)
 	var testAccActionConfigCreate = testAccCreateBlueprintConfig(identifier) + fmt.Sprintf(`
 	resource "port_action" "create_microservice" {
 	  title      = "TF Provider Test"
@@ -3678,7 +3677,7 @@ func TestAccPortActionStepsConflictWithOrder(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config:      acctest.ProviderConfig + testAccActionConfigCreate,
@@ -3689,8 +3688,8 @@ func TestAccPortActionStepsConflictWithOrder(t *testing.T) {
 }
 
 func TestAccPortActionActionTitles(t *testing.T) {
-	identifier := utils.GenID()
-	actionIdentifier := utils.GenID()
+	identifier := acctest.GenID(t)
+	actionIdentifier := acctest.GenID(t)
 	var testAccActionConfigCreate = testAccCreateBlueprintConfig(identifier) + fmt.Sprintf(`
 	resource "port_action" "create_microservice" {
 		title = "TF Provider Test"
@@ -3714,7 +3713,7 @@ func TestAccPortActionActionTitles(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: acctest.ProviderConfig + testAccActionConfigCreate,
@@ -3733,8 +3732,8 @@ func TestAccPortActionActionTitles(t *testing.T) {
 }
 
 func TestAccPortActionActionTitlesWithVisible(t *testing.T) {
-	identifier := utils.GenID()
-	actionIdentifier := utils.GenID()
+	identifier := acctest.GenID(t)
+	actionIdentifier := acctest.GenID(t)
 	var testAccActionConfigCreate = testAccCreateBlueprintConfig(identifier) + fmt.Sprintf(`
 	resource "port_action" "create_microservice" {
 		title = "TF Provider Test"
@@ -3759,7 +3758,7 @@ func TestAccPortActionActionTitlesWithVisible(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: acctest.ProviderConfig + testAccActionConfigCreate,
@@ -3779,8 +3778,8 @@ func TestAccPortActionActionTitlesWithVisible(t *testing.T) {
 }
 
 func TestAccPortActionActionTitlesWithVisibleJqQuery(t *testing.T) {
-	identifier := utils.GenID()
-	actionIdentifier := utils.GenID()
+	identifier := acctest.GenID(t)
+	actionIdentifier := acctest.GenID(t)
 	var testAccActionConfigCreate = testAccCreateBlueprintConfig(identifier) + fmt.Sprintf(`
 	resource "port_action" "create_microservice" {
 		title = "TF Provider Test"
@@ -3805,7 +3804,7 @@ func TestAccPortActionActionTitlesWithVisibleJqQuery(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: acctest.ProviderConfig + testAccActionConfigCreate,
@@ -3825,8 +3824,8 @@ func TestAccPortActionActionTitlesWithVisibleJqQuery(t *testing.T) {
 }
 
 func TestAccPortActionStepsWithVisible(t *testing.T) {
-	identifier := utils.GenID()
-	actionIdentifier := utils.GenID()
+	identifier := acctest.GenID(t)
+	actionIdentifier := acctest.GenID(t)
 	var testAccActionConfigCreate = testAccCreateBlueprintConfig(identifier) + fmt.Sprintf(`
 	resource "port_action" "create_microservice" {
 	  title      = "TF Provider Test"
@@ -3862,7 +3861,7 @@ func TestAccPortActionStepsWithVisible(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: acctest.ProviderConfig + testAccActionConfigCreate,
@@ -3884,8 +3883,8 @@ func TestAccPortActionStepsWithVisible(t *testing.T) {
 }
 
 func TestAccPortActionStepsVisibleJqQuery(t *testing.T) {
-	identifier := utils.GenID()
-	actionIdentifier := utils.GenID()
+	identifier := acctest.GenID(t)
+	actionIdentifier := acctest.GenID(t)
 	var testAccActionConfigCreate = testAccCreateBlueprintConfig(identifier) + fmt.Sprintf(`
 	resource "port_action" "create_microservice" {
 	  title      = "TF Provider Test"
@@ -3921,7 +3920,7 @@ func TestAccPortActionStepsVisibleJqQuery(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: acctest.ProviderConfig + testAccActionConfigCreate,
@@ -3943,8 +3942,8 @@ func TestAccPortActionStepsVisibleJqQuery(t *testing.T) {
 }
 
 func TestAccPortActionAllowAnyoneToViewRuns(t *testing.T) {
-	identifier := utils.GenID()
-	actionIdentifier := utils.GenID()
+	identifier := acctest.GenID(t)
+	actionIdentifier := acctest.GenID(t)
 
 	type testAllowAnyoneToViewRunsParams struct {
 		allowAnyoneToViewRuns bool
@@ -3967,7 +3966,7 @@ func TestAccPortActionAllowAnyoneToViewRuns(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: acctest.ProviderConfig + testConfig(testAllowAnyoneToViewRunsParams{allowAnyoneToViewRuns: true}),
@@ -3992,8 +3991,8 @@ func TestAccPortActionAllowAnyoneToViewRuns(t *testing.T) {
 }
 
 func TestAccPortActionButtonTexts(t *testing.T) {
-	identifier := utils.GenID()
-	actionIdentifier := utils.GenID()
+	identifier := acctest.GenID(t)
+	actionIdentifier := acctest.GenID(t)
 
 	type testButtonTextsParams struct {
 		actionCardButtonText    string
@@ -4018,7 +4017,7 @@ func TestAccPortActionButtonTexts(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: acctest.ProviderConfig + testConfig(testButtonTextsParams{
@@ -4051,8 +4050,8 @@ func TestAccPortActionButtonTexts(t *testing.T) {
 }
 
 func TestAccPortActionArrayObjectItemsDefault(t *testing.T) {
-	identifier := utils.GenID()
-	actionIdentifier := utils.GenID()
+	identifier := acctest.GenID(t)
+	actionIdentifier := acctest.GenID(t)
 	var testAccActionConfigCreate = testAccCreateBlueprintConfig(identifier) + fmt.Sprintf(`
 	resource "port_action" "create_microservice" {
 		title = "TF Provider Test - Object Items Default"
@@ -4090,7 +4089,7 @@ func TestAccPortActionArrayObjectItemsDefault(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: acctest.ProviderConfig + testAccActionConfigCreate,
@@ -4118,8 +4117,8 @@ func TestAccPortActionArrayObjectItemsDefault(t *testing.T) {
 }
 
 func TestAccPortAutomationWithEmptyJqConditionExpressions(t *testing.T) {
-	identifier := utils.GenID()
-	actionIdentifier := utils.GenID()
+	identifier := acctest.GenID(t)
+	actionIdentifier := acctest.GenID(t)
 	var testAccActionConfigCreate = testAccCreateBlueprintConfig(identifier) + fmt.Sprintf(`
 	resource "port_action" "create_microservice" {
 		title = "TF Provider Test"
@@ -4138,7 +4137,7 @@ func TestAccPortAutomationWithEmptyJqConditionExpressions(t *testing.T) {
 	}`, actionIdentifier)
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: acctest.ProviderConfig + testAccActionConfigCreate,
@@ -4163,8 +4162,8 @@ func TestAccPortAutomationWithEmptyJqConditionExpressions(t *testing.T) {
 }
 
 func TestAccPortAutomationWithJqConditionExpressions(t *testing.T) {
-	identifier := utils.GenID()
-	actionIdentifier := utils.GenID()
+	identifier := acctest.GenID(t)
+	actionIdentifier := acctest.GenID(t)
 	var testAccActionConfigCreate = testAccCreateBlueprintConfig(identifier) + fmt.Sprintf(`
 	resource "port_action" "create_microservice" {
 		title = "TF Provider Test"
@@ -4183,7 +4182,7 @@ func TestAccPortAutomationWithJqConditionExpressions(t *testing.T) {
 	}`, actionIdentifier)
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: acctest.ProviderConfig + testAccActionConfigCreate,
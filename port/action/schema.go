@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -21,6 +22,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/jq"
 	"github.com/port-labs/terraform-provider-port-labs/v2/internal/utils"
 )
 
@@ -120,6 +122,7 @@ func StringBooleanOrJQTemplateValidator() []validator.String {
 				regexp.MustCompile(`^[\n\r\s]*{{.*}}[\n\r\s]*$`),
 				"must be a valid jq template: {{JQ_EXPRESSION}}",
 			)),
+		jq.TemplateValidator(),
 	}
 }
 
@@ -198,6 +201,7 @@ func ActionSchema() map[string]schema.Attribute {
 								Optional:            true,
 								Validators: []validator.String{
 									stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("visible")),
+									jq.ExpressionValidator(),
 								},
 							},
 						},
@@ -206,6 +210,7 @@ func ActionSchema() map[string]schema.Attribute {
 				"required_jq_query": schema.StringAttribute{
 					MarkdownDescription: "The required jq query of the property",
 					Optional:            true,
+					Validators:          []validator.String{jq.ExpressionValidator()},
 				},
 				"order_properties": schema.ListAttribute{
 					MarkdownDescription: "Order properties",
@@ -238,6 +243,7 @@ func ActionSchema() map[string]schema.Attribute {
 								Optional:            true,
 								Validators: []validator.String{
 									stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("visible")),
+									jq.ExpressionValidator(),
 								},
 							},
 						},
@@ -376,6 +382,7 @@ func ActionSchema() map[string]schema.Attribute {
 							MarkdownDescription: "The jq expressions of the condition. Can be an empty array to indicate no conditions.",
 							ElementType:         types.StringType,
 							Optional:            true,
+							Validators:          []validator.List{listvalidator.ValueStringsAre(jq.ExpressionValidator())},
 						},
 						"combinator": schema.StringAttribute{
 							MarkdownDescription: "The combinator of the condition",
@@ -397,6 +404,7 @@ func ActionSchema() map[string]schema.Attribute {
 				"payload": schema.StringAttribute{
 					MarkdownDescription: "The Kafka message [payload](https://docs.getport.io/create-self-service-experiences/setup-backend/#define-the-actions-payload) should be in `JSON` format, encoded as a string. Use [jsonencode](https://developer.hashicorp.com/terraform/language/functions/jsonencode) to encode arrays or objects. Learn about how to [define the action payload](https://docs.getport.io/create-self-service-experiences/setup-backend/#define-the-actions-payload).",
 					Optional:            true,
+					Validators:          []validator.String{jq.TemplateValidator()},
 				},
 			},
 			Validators: []validator.Object{
@@ -437,10 +445,12 @@ func ActionSchema() map[string]schema.Attribute {
 					MarkdownDescription: "The HTTP headers for invoking the action. They should be encoded as a key-value object to a string using [jsonencode](https://developer.hashicorp.com/terraform/language/functions/jsonencode). Learn about how to [define the action payload](https://docs.getport.io/create-self-service-experiences/setup-backend/#define-the-actions-payload).",
 					ElementType:         types.StringType,
 					Optional:            true,
+					Validators:          []validator.Map{mapvalidator.ValueStringsAre(jq.TemplateValidator())},
 				},
 				"body": schema.StringAttribute{
 					MarkdownDescription: "The Webhook body should be in `JSON` format, encoded as a string. Use [jsonencode](https://developer.hashicorp.com/terraform/language/functions/jsonencode) to encode arrays or objects. Learn about how to [define the action payload](https://docs.getport.io/create-self-service-experiences/setup-backend/#define-the-actions-payload).",
 					Optional:            true,
+					Validators:          []validator.String{jq.TemplateValidator()},
 				},
 			},
 		},
@@ -463,6 +473,7 @@ func ActionSchema() map[string]schema.Attribute {
 				"workflow_inputs": schema.StringAttribute{
 					MarkdownDescription: "The GitHub [workflow inputs](https://docs.getport.io/create-self-service-experiences/setup-backend/#define-the-actions-payload) should be in `JSON` format, encoded as a string. Use [jsonencode](https://developer.hashicorp.com/terraform/language/functions/jsonencode) to encode arrays or objects. Learn about how to [define the action payload](https://docs.getport.io/create-self-service-experiences/setup-backend/#define-the-actions-payload).",
 					Optional:            true,
+					Validators:          []validator.String{jq.TemplateValidator()},
 				},
 				"report_workflow_status": schema.StringAttribute{
 					MarkdownDescription: "Report the workflow status when invoking the action",
@@ -490,6 +501,7 @@ func ActionSchema() map[string]schema.Attribute {
 				"pipeline_variables": schema.StringAttribute{
 					MarkdownDescription: "The Gitlab pipeline variables should be in `JSON` format, encoded as a string. Use [jsonencode](https://developer.hashicorp.com/terraform/language/functions/jsonencode) to encode arrays or objects. Learn about how to [define the action payload](https://docs.getport.io/create-self-service-experiences/setup-backend/#define-the-actions-payload).",
 					Optional:            true,
+					Validators:          []validator.String{jq.TemplateValidator()},
 				},
 			},
 		},
@@ -508,6 +520,7 @@ func ActionSchema() map[string]schema.Attribute {
 				"payload": schema.StringAttribute{
 					MarkdownDescription: "The Azure Devops workflow [payload](https://docs.getport.io/create-self-service-experiences/setup-backend/#define-the-actions-payload) should be in `JSON` format, encoded as a string. Use [jsonencode](https://developer.hashicorp.com/terraform/language/functions/jsonencode) to encode arrays or objects. Learn about how to [define the action payload](https://docs.getport.io/create-self-service-experiences/setup-backend/#define-the-actions-payload).",
 					Optional:            true,
+					Validators:          []validator.String{jq.TemplateValidator()},
 				},
 			},
 		},
@@ -518,6 +531,7 @@ func ActionSchema() map[string]schema.Attribute {
 				"title": schema.StringAttribute{
 					MarkdownDescription: "The title of the entity",
 					Optional:            true,
+					Validators:          []validator.String{jq.TemplateValidator()},
 				},
 				"blueprint_identifier": schema.StringAttribute{
 					MarkdownDescription: "Required when selecting type Upsert Entity. The blueprint identifier of the entity for the upsert",
@@ -530,6 +544,7 @@ func ActionSchema() map[string]schema.Attribute {
 						"identifier": schema.StringAttribute{
 							MarkdownDescription: "Required when selecting type Upsert Entity. The entity identifier for the upsert",
 							Optional:            true,
+							Validators:          []validator.String{jq.TemplateValidator()},
 						},
 						"teams": schema.SetAttribute{
 							MarkdownDescription: "The teams the entity belongs to",
@@ -541,19 +556,23 @@ func ActionSchema() map[string]schema.Attribute {
 							Optional:            true,
 							Validators: []validator.String{
 								stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("teams")),
+								jq.TemplateValidator(),
 							},
 						},
 						"icon": schema.StringAttribute{
 							MarkdownDescription: "The icon of the entity",
 							Optional:            true,
+							Validators:          []validator.String{jq.TemplateValidator()},
 						},
 						"properties": schema.StringAttribute{
 							MarkdownDescription: "The properties of the entity (key-value object encoded to a string)",
 							Optional:            true,
+							Validators:          []validator.String{jq.TemplateValidator()},
 						},
 						"relations": schema.StringAttribute{
 							MarkdownDescription: "The relations of the entity (key-value object encoded to a string)",
 							Optional:            true,
+							Validators:          []validator.String{jq.TemplateValidator()},
 						},
 					},
 				},
@@ -590,6 +609,7 @@ func ActionSchema() map[string]schema.Attribute {
 						"workflow_inputs": schema.StringAttribute{
 							MarkdownDescription: "The workflow inputs should be in `JSON` format, encoded as a string. Use [jsonencode](https://developer.hashicorp.com/terraform/language/functions/jsonencode) to encode arrays or objects.",
 							Optional:            true,
+							Validators:          []validator.String{jq.TemplateValidator()},
 						},
 						"report_workflow_status": schema.StringAttribute{
 							MarkdownDescription: "Whether to report the workflow status",
@@ -656,6 +676,7 @@ func StringPropertySchema() schema.Attribute {
 			Optional:            true,
 			Validators: []validator.String{
 				stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("default")),
+				jq.ExpressionValidator(),
 			},
 		},
 		"blueprint": schema.StringAttribute{
@@ -690,6 +711,7 @@ func StringPropertySchema() schema.Attribute {
 			Validators: []validator.String{
 				stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("pattern")),
 				stringvalidator.LengthAtLeast(1),
+				jq.ExpressionValidator(),
 			},
 		},
 		"enum": schema.ListAttribute{
@@ -711,6 +733,7 @@ func StringPropertySchema() schema.Attribute {
 			Optional:            true,
 			Validators: []validator.String{
 				stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("enum")),
+				jq.ExpressionValidator(),
 			},
 		},
 		"encryption": schema.StringAttribute{
@@ -747,6 +770,7 @@ func StringPropertySchema() schema.Attribute {
 			Optional:            true,
 			Validators: []validator.String{
 				stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("visible")),
+				jq.ExpressionValidator(),
 			},
 		},
 		"disabled": schema.BoolAttribute{
@@ -758,6 +782,7 @@ func StringPropertySchema() schema.Attribute {
 			Optional:            true,
 			Validators: []validator.String{
 				stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("disabled")),
+				jq.ExpressionValidator(),
 			},
 		},
 		"dataset": schema.SingleNestedAttribute{
@@ -822,6 +847,7 @@ func NumberPropertySchema() schema.Attribute {
 			Optional:            true,
 			Validators: []validator.String{
 				stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("default")),
+				jq.ExpressionValidator(),
 			},
 		},
 		"maximum": schema.Float64Attribute{
@@ -851,6 +877,7 @@ func NumberPropertySchema() schema.Attribute {
 			Optional:            true,
 			Validators: []validator.String{
 				stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("enum")),
+				jq.ExpressionValidator(),
 			},
 		},
 		"visible": schema.BoolAttribute{
@@ -862,6 +889,7 @@ func NumberPropertySchema() schema.Attribute {
 			Optional:            true,
 			Validators: []validator.String{
 				stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("visible")),
+				jq.ExpressionValidator(),
 			},
 		},
 		"disabled": schema.BoolAttribute{
@@ -873,6 +901,7 @@ func NumberPropertySchema() schema.Attribute {
 			Optional:            true,
 			Validators: []validator.String{
 				stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("disabled")),
+				jq.ExpressionValidator(),
 			},
 		},
 	}
@@ -898,6 +927,7 @@ func BooleanPropertySchema() schema.Attribute {
 			Optional:            true,
 			Validators: []validator.String{
 				stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("default")),
+				jq.ExpressionValidator(),
 			},
 		},
 		"visible": schema.BoolAttribute{
@@ -909,6 +939,7 @@ func BooleanPropertySchema() schema.Attribute {
 			Optional:            true,
 			Validators: []validator.String{
 				stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("visible")),
+				jq.ExpressionValidator(),
 			},
 		},
 		"disabled": schema.BoolAttribute{
@@ -920,6 +951,7 @@ func BooleanPropertySchema() schema.Attribute {
 			Optional:            true,
 			Validators: []validator.String{
 				stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("disabled")),
+				jq.ExpressionValidator(),
 			},
 		},
 	}
@@ -945,6 +977,7 @@ func ObjectPropertySchema() schema.Attribute {
 			MarkdownDescription: "The default jq query of the object property",
 			Validators: []validator.String{
 				stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("default")),
+				jq.ExpressionValidator(),
 			},
 		},
 		"encryption": schema.StringAttribute{
@@ -981,6 +1014,7 @@ func ObjectPropertySchema() schema.Attribute {
 			Optional:            true,
 			Validators: []validator.String{
 				stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("visible")),
+				jq.ExpressionValidator(),
 			},
 		},
 		"disabled": schema.BoolAttribute{
@@ -992,6 +1026,7 @@ func ObjectPropertySchema() schema.Attribute {
 			Optional:            true,
 			Validators: []validator.String{
 				stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("disabled")),
+				jq.ExpressionValidator(),
 			},
 		},
 	}
@@ -1019,6 +1054,7 @@ func ArrayPropertySchema() schema.Attribute {
 			Optional:            true,
 			Validators: []validator.String{
 				stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("min_items")),
+				jq.ExpressionValidator(),
 			},
 		},
 		"max_items": schema.Int64Attribute{
@@ -1033,6 +1069,7 @@ func ArrayPropertySchema() schema.Attribute {
 			Optional:            true,
 			Validators: []validator.String{
 				stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("max_items")),
+				jq.ExpressionValidator(),
 			},
 		},
 		"default_jq_query": schema.StringAttribute{
@@ -1043,6 +1080,7 @@ func ArrayPropertySchema() schema.Attribute {
 				stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("number_items").AtName("default")),
 				stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("boolean_items").AtName("default")),
 				stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("object_items").AtName("default")),
+				jq.ExpressionValidator(),
 			},
 		},
 		"string_items": schema.SingleNestedAttribute{
@@ -1076,6 +1114,7 @@ func ArrayPropertySchema() schema.Attribute {
 					Optional:            true,
 					Validators: []validator.String{
 						stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("enum")),
+						jq.ExpressionValidator(),
 					},
 				},
 				"dataset": schema.StringAttribute{
@@ -1107,6 +1146,7 @@ func ArrayPropertySchema() schema.Attribute {
 					Optional:            true,
 					Validators: []validator.String{
 						stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("enum")),
+						jq.ExpressionValidator(),
 					},
 				},
 			},
@@ -1142,6 +1182,7 @@ func ArrayPropertySchema() schema.Attribute {
 			Optional:            true,
 			Validators: []validator.String{
 				stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("visible")),
+				jq.ExpressionValidator(),
 			},
 		},
 		"disabled": schema.BoolAttribute{
@@ -1153,6 +1194,7 @@ func ArrayPropertySchema() schema.Attribute {
 			Optional:            true,
 			Validators: []validator.String{
 				stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("disabled")),
+				jq.ExpressionValidator(),
 			},
 		},
 		"sort": schema.SingleNestedAttribute{
@@ -6,7 +6,6 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/port-labs/terraform-provider-port-labs/v2/internal/acctest"
-	"github.com/port-labs/terraform-provider-port-labs/v2/internal/utils"
 )
 
 func baseBlueprintsTemplate(parentBlueprintIdentifier string, childBlueprintIdentifier string) string {
@@ -46,8 +45,8 @@ func TestAccPortAggregationPropertyWithCycleRelation(t *testing.T) {
 	// The child blueprint has a relation to the parent blueprint.
 	// The parent blueprint has an aggregation property that counts the children of the parent.
 	// The aggregation property is created with a cycle relation to the child blueprint, which is allowed.
-	parentBlueprintIdentifier := utils.GenID()
-	childBlueprintIdentifier := utils.GenID()
+	parentBlueprintIdentifier := acctest.GenID(t)
+	childBlueprintIdentifier := acctest.GenID(t)
 	var testAccActionConfigCreate = baseBlueprintsTemplate(parentBlueprintIdentifier, childBlueprintIdentifier) + `
 	resource "port_aggregation_properties" "child_aggregation_properties" {
 		blueprint_identifier = port_blueprint.parent_blueprint.identifier
@@ -67,7 +66,7 @@ func TestAccPortAggregationPropertyWithCycleRelation(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: acctest.ProviderConfig + testAccActionConfigCreate,
@@ -85,8 +84,8 @@ func TestAccPortAggregationPropertyWithCycleRelation(t *testing.T) {
 }
 
 func TestAccCreateAggregationPropertyAverageEntities(t *testing.T) {
-	parentBlueprintIdentifier := utils.GenID()
-	childBlueprintIdentifier := utils.GenID()
+	parentBlueprintIdentifier := acctest.GenID(t)
+	childBlueprintIdentifier := acctest.GenID(t)
 	var testAccActionConfigCreate = baseBlueprintsTemplate(parentBlueprintIdentifier, childBlueprintIdentifier) + `
 	resource "port_aggregation_properties" "parent_aggregation_properties" {
 		blueprint_identifier = port_blueprint.parent_blueprint.identifier
@@ -126,7 +125,7 @@ func TestAccCreateAggregationPropertyAverageEntities(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: acctest.ProviderConfig + testAccActionConfigCreate,
@@ -157,8 +156,8 @@ func TestAccCreateAggregationPropertyAverageEntities(t *testing.T) {
 }
 
 func TestAccPortCreateAggregationAverageProperties(t *testing.T) {
-	parentBlueprintIdentifier := utils.GenID()
-	childBlueprintIdentifier := utils.GenID()
+	parentBlueprintIdentifier := acctest.GenID(t)
+	childBlueprintIdentifier := acctest.GenID(t)
 	var testAccActionConfigCreate = baseBlueprintsTemplate(parentBlueprintIdentifier, childBlueprintIdentifier) + `
 	resource "port_aggregation_properties" "child_aggregation_properties" {
 		blueprint_identifier = port_blueprint.child_blueprint.identifier
@@ -203,7 +202,7 @@ func TestAccPortCreateAggregationAverageProperties(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: acctest.ProviderConfig + testAccActionConfigCreate,
@@ -236,8 +235,8 @@ func TestAccPortCreateAggregationAverageProperties(t *testing.T) {
 }
 
 func TestAccPortCreateAggregationPropertyAggregateByProperty(t *testing.T) {
-	parentBlueprintIdentifier := utils.GenID()
-	childBlueprintIdentifier := utils.GenID()
+	parentBlueprintIdentifier := acctest.GenID(t)
+	childBlueprintIdentifier := acctest.GenID(t)
 	var testAccActionConfigCreateAggrByPropMin = baseBlueprintsTemplate(parentBlueprintIdentifier, childBlueprintIdentifier) + `
 	resource "port_aggregation_properties" "child_aggregation_properties" {
 		blueprint_identifier = port_blueprint.child_blueprint.identifier
@@ -320,7 +319,7 @@ func TestAccPortCreateAggregationPropertyAggregateByProperty(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: acctest.ProviderConfig + testAccActionConfigCreateAggrByPropMin,
@@ -375,8 +374,8 @@ func TestAccPortCreateAggregationPropertyAggregateByProperty(t *testing.T) {
 }
 
 func TestAccPortCreateBlueprintWithAggregationByPropertyWithFilter(t *testing.T) {
-	parentBlueprintIdentifier := utils.GenID()
-	childBlueprintIdentifier := utils.GenID()
+	parentBlueprintIdentifier := acctest.GenID(t)
+	childBlueprintIdentifier := acctest.GenID(t)
 	var testAccActionConfigCreate = baseBlueprintsTemplate(parentBlueprintIdentifier, childBlueprintIdentifier) + `
 
 	resource "port_aggregation_properties" "child_aggregation_properties" {
@@ -412,7 +411,7 @@ func TestAccPortCreateBlueprintWithAggregationByPropertyWithFilter(t *testing.T)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: acctest.ProviderConfig + testAccActionConfigCreate,
@@ -432,8 +431,8 @@ func TestAccPortCreateBlueprintWithAggregationByPropertyWithFilter(t *testing.T)
 }
 
 func TestAccMultipleAggregationPropertiesForBlueprintCreate(t *testing.T) {
-	parentBlueprintIdentifier := utils.GenID()
-	childBlueprintIdentifier := utils.GenID()
+	parentBlueprintIdentifier := acctest.GenID(t)
+	childBlueprintIdentifier := acctest.GenID(t)
 	var testAccActionConfigCreate = baseBlueprintsTemplate(parentBlueprintIdentifier, childBlueprintIdentifier) + `
 	resource "port_aggregation_properties" "child_aggregation_properties" {
 		blueprint_identifier = port_blueprint.child_blueprint.identifier
@@ -492,7 +491,7 @@ func TestAccMultipleAggregationPropertiesForBlueprintCreate(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: acctest.ProviderConfig + testAccActionConfigCreate,
@@ -519,9 +518,9 @@ func TestAccMultipleAggregationPropertiesForBlueprintCreate(t *testing.T) {
 }
 
 func TestAccPortCreateAggregationPropertyWithPathFilter(t *testing.T) {
-	parentBlueprintIdentifier := utils.GenID()
-	childBlueprintIdentifier := utils.GenID()
-	intermediateBlueprintIdentifier := utils.GenID()
+	parentBlueprintIdentifier := acctest.GenID(t)
+	childBlueprintIdentifier := acctest.GenID(t)
+	intermediateBlueprintIdentifier := acctest.GenID(t)
 
 	var testAccActionConfigCreate = fmt.Sprintf(`
 	resource "port_blueprint" "parent_blueprint" {
@@ -587,7 +586,7 @@ func TestAccPortCreateAggregationPropertyWithPathFilter(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: acctest.ProviderConfig + testAccActionConfigCreate,
@@ -607,9 +606,9 @@ func TestAccPortCreateAggregationPropertyWithPathFilter(t *testing.T) {
 }
 
 func TestAccPortCreateAggregationPropertyWithPathFilterAndFromBlueprint(t *testing.T) {
-	parentBlueprintIdentifier := utils.GenID()
-	childBlueprintIdentifier := utils.GenID()
-	intermediateBlueprintIdentifier := utils.GenID()
+	parentBlueprintIdentifier := acctest.GenID(t)
+	childBlueprintIdentifier := acctest.GenID(t)
+	intermediateBlueprintIdentifier := acctest.GenID(t)
 
 	var testAccActionConfigCreate = fmt.Sprintf(`
 	resource "port_blueprint" "parent_blueprint" {
@@ -676,7 +675,7 @@ func TestAccPortCreateAggregationPropertyWithPathFilterAndFromBlueprint(t *testi
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: acctest.ProviderConfig + testAccActionConfigCreate,
@@ -697,9 +696,9 @@ func TestAccPortCreateAggregationPropertyWithPathFilterAndFromBlueprint(t *testi
 }
 
 func TestAccPortAddAndRemovePathFilterFromAggregationProperty(t *testing.T) {
-	parentBlueprintIdentifier := utils.GenID()
-	childBlueprintIdentifier := utils.GenID()
-	intermediateBlueprintIdentifier := utils.GenID()
+	parentBlueprintIdentifier := acctest.GenID(t)
+	childBlueprintIdentifier := acctest.GenID(t)
+	intermediateBlueprintIdentifier := acctest.GenID(t)
 
 	var testAccActionConfigWithoutPathFilter = fmt.Sprintf(`
 	resource "port_blueprint" "parent_blueprint" {
@@ -879,7 +878,7 @@ func TestAccPortAddAndRemovePathFilterFromAggregationProperty(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: acctest.ProviderConfig + testAccActionConfigWithoutPathFilter,
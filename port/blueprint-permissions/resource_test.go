@@ -8,7 +8,6 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/port-labs/terraform-provider-port-labs/v2/internal/acctest"
-	"github.com/port-labs/terraform-provider-port-labs/v2/internal/utils"
 )
 
 func createBlueprint(identifier string) string {
@@ -56,7 +55,7 @@ resource "port_blueprint" "microservice" {
 }
 
 func TestAccPortBlueprintPermissionsBasic(t *testing.T) {
-	blueprintIdentifier := utils.GenID()
+	blueprintIdentifier := acctest.GenID(t)
 	err := os.Setenv("PORT_BETA_FEATURES_ENABLED", "true")
 	if err != nil {
 		t.Fatal(err)
@@ -124,7 +123,7 @@ func TestAccPortBlueprintPermissionsBasic(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccPortBlueprintResourceBasic,
@@ -155,7 +154,7 @@ func TestAccPortBlueprintPermissionsBasic(t *testing.T) {
 }
 
 func TestAccPortBlueprintPermissionsWithProperties(t *testing.T) {
-	blueprintIdentifier := utils.GenID()
+	blueprintIdentifier := acctest.GenID(t)
 	err := os.Setenv("PORT_BETA_FEATURES_ENABLED", "true")
 	if err != nil {
 		t.Fatal(err)
@@ -230,7 +229,7 @@ func TestAccPortBlueprintPermissionsWithProperties(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccPortBlueprintResourceBasic,
@@ -260,8 +259,8 @@ func TestAccPortBlueprintPermissionsWithProperties(t *testing.T) {
 }
 
 func TestAccPortBlueprintPermissionsWithRelations(t *testing.T) {
-	blueprintMicroserviceIdentifier := utils.GenID()
-	blueprintEnvIdentifier := utils.GenID()
+	blueprintMicroserviceIdentifier := acctest.GenID(t)
+	blueprintEnvIdentifier := acctest.GenID(t)
 	err := os.Setenv("PORT_BETA_FEATURES_ENABLED", "true")
 	if err != nil {
 		t.Fatal(err)
@@ -301,7 +300,7 @@ resource "port_blueprint" "microservice" {
 }
 `, blueprintEnvIdentifier, blueprintMicroserviceIdentifier)
 
-	teamName := utils.GenID()
+	teamName := acctest.GenID(t)
 	var testAccBaseBlueprintPermissionsConfigUpdate = fmt.Sprintf(`
 
 	resource "port_system_blueprint" "team" {
@@ -396,7 +395,7 @@ resource "port_blueprint" "microservice" {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccPortBlueprintResourceBasic,
@@ -429,14 +428,14 @@ resource "port_blueprint" "microservice" {
 }
 
 func TestAccPortBlueprintPermissionsWithInvalidProperties(t *testing.T) {
-	blueprintIdentifier := utils.GenID()
+	blueprintIdentifier := acctest.GenID(t)
 	err := os.Setenv("PORT_BETA_FEATURES_ENABLED", "true")
 	if err != nil {
 		t.Fatal(err)
 	}
 	var testAccPortBlueprintResourceBasic = createBlueprintWithProperties(blueprintIdentifier)
 
-	teamName := utils.GenID()
+	teamName := acctest.GenID(t)
 	var testAccBaseBlueprintPermissionsConfigUpdate = fmt.Sprintf(`
 
 	resource "port_team" "team" {
@@ -511,7 +510,7 @@ func TestAccPortBlueprintPermissionsWithInvalidProperties(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config:      testAccPortBlueprintResourceBasic + testAccBaseBlueprintPermissionsConfigUpdate,
@@ -522,7 +521,7 @@ func TestAccPortBlueprintPermissionsWithInvalidProperties(t *testing.T) {
 }
 
 func TestAccPortBlueprintPermissionsWithPolicy(t *testing.T) {
-	blueprintIdentifier := utils.GenID()
+	blueprintIdentifier := acctest.GenID(t)
 	err := os.Setenv("PORT_BETA_FEATURES_ENABLED", "true")
 	if err != nil {
 		t.Fatal(err)
@@ -662,7 +661,7 @@ func TestAccPortBlueprintPermissionsWithPolicy(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccPortBlueprintResourceBasic + testAccBlueprintPermissionsWithPolicy,
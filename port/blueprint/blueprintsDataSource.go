@@ -0,0 +1,120 @@
+package blueprint
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
+)
+
+var _ datasource.DataSource = &BlueprintsDataSource{}
+
+func NewBlueprintsDataSource() datasource.DataSource {
+	return &BlueprintsDataSource{}
+}
+
+type BlueprintsDataSource struct {
+	portClient *cli.PortClient
+}
+
+func (d *BlueprintsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.portClient = req.ProviderData.(*cli.PortClient)
+}
+
+func (d *BlueprintsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_blueprints"
+}
+
+func (d *BlueprintsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data BlueprintsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var titleRegex *regexp.Regexp
+	if !data.TitleRegex.IsNull() {
+		var err error
+		titleRegex, err = regexp.Compile(data.TitleRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("title_regex"), "invalid title regex", err.Error())
+			return
+		}
+	}
+
+	blueprints, err := d.portClient.ReadBlueprints(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("failed reading blueprints", err.Error())
+		return
+	}
+
+	filtered := filterBlueprints(blueprints, data.IdentifierPrefix.ValueString(), titleRegex, data.RelationTarget.ValueString())
+
+	data.ID = types.StringValue(data.GenerateID())
+	data.Blueprints = make([]BlueprintDataSourceModel, 0, len(filtered))
+	for i := range filtered {
+		b, err := refreshBlueprintDataSourceState(ctx, d.portClient, &filtered[i])
+		if err != nil {
+			resp.Diagnostics.AddError("failed writing blueprint fields to data source", err.Error())
+			return
+		}
+		data.Blueprints = append(data.Blueprints, *b)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// filterBlueprints returns the blueprints matching all the given filters, sorted by identifier. Empty filters match
+// every blueprint.
+func filterBlueprints(blueprints []cli.Blueprint, identifierPrefix string, titleRegex *regexp.Regexp, relationTarget string) []cli.Blueprint {
+	filtered := make([]cli.Blueprint, 0, len(blueprints))
+	for _, b := range blueprints {
+		if !strings.HasPrefix(b.Identifier, identifierPrefix) {
+			continue
+		}
+		if titleRegex != nil && !titleRegex.MatchString(b.Title) {
+			continue
+		}
+		if relationTarget != "" && !hasRelationTarget(b, relationTarget) {
+			continue
+		}
+		filtered = append(filtered, b)
+	}
+
+	sort.Slice(filtered, func(i, j int) bool {
+		return filtered[i].Identifier < filtered[j].Identifier
+	})
+	return filtered
+}
+
+func hasRelationTarget(b cli.Blueprint, target string) bool {
+	for _, relation := range b.Relations {
+		if relation.Target != nil && *relation.Target == target {
+			return true
+		}
+	}
+	return false
+}
+
+func (m *BlueprintsDataSourceModel) GenerateID() string {
+	filters := strings.Join([]string{
+		m.IdentifierPrefix.ValueString(),
+		m.TitleRegex.ValueString(),
+		m.RelationTarget.ValueString(),
+	}, "\n")
+
+	hash := sha256.Sum256([]byte(filters))
+	return hex.EncodeToString(hash[:])
+}
@@ -0,0 +1,101 @@
+package blueprint
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/utils"
+)
+
+func BlueprintsDataSourceSchema() map[string]schema.Attribute {
+	blueprintAttributes := map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed: true,
+		},
+		"identifier": schema.StringAttribute{
+			MarkdownDescription: "The identifier of the blueprint",
+			Computed:            true,
+		},
+	}
+	utils.CopyGenericMaps(blueprintAttributes, DataSourceBlueprintAttributes())
+
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed: true,
+		},
+		"identifier_prefix": schema.StringAttribute{
+			MarkdownDescription: "Only return blueprints whose identifier starts with this prefix",
+			Optional:            true,
+		},
+		"title_regex": schema.StringAttribute{
+			MarkdownDescription: "Only return blueprints whose title matches this regular expression",
+			Optional:            true,
+		},
+		"relation_target": schema.StringAttribute{
+			MarkdownDescription: "Only return blueprints that have at least one relation targeting this blueprint identifier",
+			Optional:            true,
+		},
+		"blueprints": schema.ListNestedAttribute{
+			MarkdownDescription: "The blueprints matching the filters, sorted by identifier",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: blueprintAttributes,
+			},
+		},
+	}
+}
+
+func (d *BlueprintsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: BlueprintsDataSourceMarkdownDescription,
+		Attributes:          BlueprintsDataSourceSchema(),
+	}
+}
+
+var BlueprintsDataSourceMarkdownDescription = `
+
+# Blueprints Data Source
+
+The blueprints data source lists the blueprints of the organization. All filters are optional and are combined with
+a logical AND. Every blueprint in the result has the same shape as the ` + "`port_blueprint`" + ` data source.
+
+## Example Usage
+
+### List all blueprints:
+
+` + "```hcl" + `
+
+data "port_blueprints" "all" {}
+
+` + "```" + `
+
+### Create an ownership scorecard for every service-like blueprint that relates to the team blueprint:
+
+` + "```hcl" + `
+
+data "port_blueprints" "service_like" {
+  identifier_prefix = "svc_"
+  relation_target   = "team"
+}
+
+resource "port_scorecard" "ownership" {
+  for_each   = { for b in data.port_blueprints.service_like.blueprints : b.identifier => b }
+  blueprint  = each.key
+  identifier = "ownership"
+  title      = "Ownership"
+  rules = [{
+    identifier = "hasTeam"
+    title      = "Has Team"
+    level      = "Gold"
+    query = {
+      combinator = "and"
+      conditions = [jsonencode({
+        property = "$team"
+        operator = "isNotEmpty"
+      })]
+    }
+  }]
+}
+
+` + "```" + ``
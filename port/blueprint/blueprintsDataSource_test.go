@@ -0,0 +1,76 @@
+package blueprint
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFilterBlueprints(t *testing.T) {
+	blueprints := []cli.Blueprint{
+		{Identifier: "svc_payments", Title: "Payments Service", Relations: map[string]cli.Relation{
+			"team": {Target: utils.PtrTo("team")},
+		}},
+		{Identifier: "svc_ads", Title: "Ads Service"},
+		{Identifier: "environment", Title: "Environment", Relations: map[string]cli.Relation{
+			"cluster": {Target: utils.PtrTo("cluster")},
+		}},
+		{Identifier: "svc_billing", Title: "Billing", Relations: map[string]cli.Relation{
+			"owner": {Target: utils.PtrTo("team")},
+		}},
+	}
+
+	identifiers := func(bs []cli.Blueprint) []string {
+		return utils.Map(bs, func(b cli.Blueprint) string { return b.Identifier })
+	}
+
+	tests := []struct {
+		name             string
+		identifierPrefix string
+		titleRegex       *regexp.Regexp
+		relationTarget   string
+		want             []string
+	}{
+		{
+			name: "no filters returns every blueprint sorted",
+			want: []string{"environment", "svc_ads", "svc_billing", "svc_payments"},
+		},
+		{
+			name:             "identifier prefix",
+			identifierPrefix: "svc_",
+			want:             []string{"svc_ads", "svc_billing", "svc_payments"},
+		},
+		{
+			name:       "title regex",
+			titleRegex: regexp.MustCompile(`Service$`),
+			want:       []string{"svc_ads", "svc_payments"},
+		},
+		{
+			name:           "relation target",
+			relationTarget: "team",
+			want:           []string{"svc_billing", "svc_payments"},
+		},
+		{
+			name:             "filters are combined",
+			identifierPrefix: "svc_",
+			titleRegex:       regexp.MustCompile(`Service`),
+			relationTarget:   "team",
+			want:             []string{"svc_payments"},
+		},
+		{
+			name:           "no match",
+			relationTarget: "missing",
+			want:           []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := filterBlueprints(blueprints, tt.identifierPrefix, tt.titleRegex, tt.relationTarget)
+			assert.Equal(t, tt.want, identifiers(got))
+		})
+	}
+}
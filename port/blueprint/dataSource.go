@@ -0,0 +1,88 @@
+package blueprint
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
+)
+
+var _ datasource.DataSource = &BlueprintDataSource{}
+
+func NewBlueprintDataSource() datasource.DataSource {
+	return &BlueprintDataSource{}
+}
+
+type BlueprintDataSource struct {
+	portClient *cli.PortClient
+}
+
+func (d *BlueprintDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.portClient = req.ProviderData.(*cli.PortClient)
+}
+
+func (d *BlueprintDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_blueprint"
+}
+
+func (d *BlueprintDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data BlueprintDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	b, _, err := d.portClient.ReadBlueprint(ctx, data.Identifier.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("failed reading blueprint", err.Error())
+		return
+	}
+
+	state, err := refreshBlueprintDataSourceState(ctx, d.portClient, b)
+	if err != nil {
+		resp.Diagnostics.AddError("failed writing blueprint fields to data source", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// refreshBlueprintDataSourceState reuses the resource state refresh, so the data source exposes exactly the same
+// values the port_blueprint resource would store for the blueprint.
+func refreshBlueprintDataSourceState(ctx context.Context, portClient *cli.PortClient, b *cli.Blueprint) (*BlueprintDataSourceModel, error) {
+	bm := &BlueprintModel{
+		KafkaChangelogDestination: types.ObjectNull(map[string]attr.Type{}),
+	}
+	r := &BlueprintResource{portClient: portClient}
+	if err := r.refreshBlueprintState(ctx, bm, b); err != nil {
+		return nil, err
+	}
+
+	return &BlueprintDataSourceModel{
+		ID:                          bm.ID,
+		Identifier:                  bm.Identifier,
+		Title:                       bm.Title,
+		Icon:                        bm.Icon,
+		Description:                 bm.Description,
+		CreatedAt:                   bm.CreatedAt,
+		CreatedBy:                   bm.CreatedBy,
+		UpdatedAt:                   bm.UpdatedAt,
+		UpdatedBy:                   bm.UpdatedBy,
+		KafkaChangelogDestination:   bm.KafkaChangelogDestination,
+		WebhookChangelogDestination: bm.WebhookChangelogDestination,
+		TeamInheritance:             bm.TeamInheritance,
+		Properties:                  bm.Properties,
+		Relations:                   bm.Relations,
+		MirrorProperties:            bm.MirrorProperties,
+		CalculationProperties:       bm.CalculationProperties,
+		Ownership:                   bm.Ownership,
+		IncludeInGlobalSearch:       bm.IncludeInGlobalSearch,
+	}, nil
+}
@@ -0,0 +1,524 @@
+package blueprint
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/utils"
+)
+
+func DataSourceMetadataProperties() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"title": schema.StringAttribute{
+			MarkdownDescription: "The title of the property",
+			Computed:            true,
+		},
+		"icon": schema.StringAttribute{
+			MarkdownDescription: "The icon of the property",
+			Computed:            true,
+		},
+		"required": schema.BoolAttribute{
+			MarkdownDescription: "Whether the property is required",
+			Computed:            true,
+		},
+		"description": schema.StringAttribute{
+			MarkdownDescription: "The description of the property",
+			Computed:            true,
+		},
+	}
+}
+
+func DataSourceSpecAuthenticationSchema(description string) schema.Attribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: description,
+		Computed:            true,
+		Attributes: map[string]schema.Attribute{
+			"client_id": schema.StringAttribute{
+				MarkdownDescription: "The clientId of the spec authentication",
+				Computed:            true,
+			},
+			"token_url": schema.StringAttribute{
+				MarkdownDescription: "The tokenUrl of the spec authentication",
+				Computed:            true,
+			},
+			"authorization_url": schema.StringAttribute{
+				MarkdownDescription: "The authorizationUrl of the spec authentication",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func DataSourceStringPropertySchema() schema.Attribute {
+	stringPropertySchema := map[string]schema.Attribute{
+		"default": schema.StringAttribute{
+			MarkdownDescription: "The default of the string property",
+			Computed:            true,
+		},
+		"format": schema.StringAttribute{
+			MarkdownDescription: "The format of the string property",
+			Computed:            true,
+		},
+		"date_format": schema.StringAttribute{
+			MarkdownDescription: "Display format for `date-time` string properties (for example `24-hour`)",
+			Computed:            true,
+		},
+		"min_length": schema.Int64Attribute{
+			MarkdownDescription: "The min length of the string property",
+			Computed:            true,
+		},
+		"max_length": schema.Int64Attribute{
+			MarkdownDescription: "The max length of the string property",
+			Computed:            true,
+		},
+		"pattern": schema.StringAttribute{
+			MarkdownDescription: "The pattern of the string property",
+			Computed:            true,
+		},
+		"spec": schema.StringAttribute{
+			MarkdownDescription: "The spec of the string property",
+			Computed:            true,
+		},
+		"spec_authentication": DataSourceSpecAuthenticationSchema("The spec authentication of the string property"),
+		"enum": schema.ListAttribute{
+			MarkdownDescription: "The enum of the string property",
+			Computed:            true,
+			ElementType:         types.StringType,
+		},
+		"enum_colors": schema.MapAttribute{
+			MarkdownDescription: "The enum colors of the string property",
+			Computed:            true,
+			ElementType:         types.StringType,
+		},
+	}
+
+	utils.CopyGenericMaps(stringPropertySchema, DataSourceMetadataProperties())
+	return schema.MapNestedAttribute{
+		MarkdownDescription: "The string property of the blueprint",
+		Computed:            true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: stringPropertySchema,
+		},
+	}
+}
+
+func DataSourceNumberPropertySchema() schema.Attribute {
+	numberPropertySchema := map[string]schema.Attribute{
+		"default": schema.Float64Attribute{
+			MarkdownDescription: "The default of the number property",
+			Computed:            true,
+		},
+		"maximum": schema.Float64Attribute{
+			MarkdownDescription: "The max of the number property",
+			Computed:            true,
+		},
+		"minimum": schema.Float64Attribute{
+			MarkdownDescription: "The min of the number property",
+			Computed:            true,
+		},
+		"enum": schema.ListAttribute{
+			MarkdownDescription: "The enum of the number property",
+			Computed:            true,
+			ElementType:         types.Float64Type,
+		},
+		"enum_colors": schema.MapAttribute{
+			MarkdownDescription: "The enum colors of the number property",
+			Computed:            true,
+			ElementType:         types.StringType,
+		},
+	}
+
+	utils.CopyGenericMaps(numberPropertySchema, DataSourceMetadataProperties())
+	return schema.MapNestedAttribute{
+		MarkdownDescription: "The number property of the blueprint",
+		Computed:            true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: numberPropertySchema,
+		},
+	}
+}
+
+func DataSourceBooleanPropertySchema() schema.Attribute {
+	booleanPropertySchema := map[string]schema.Attribute{
+		"default": schema.BoolAttribute{
+			MarkdownDescription: "The default of the boolean property",
+			Computed:            true,
+		},
+	}
+
+	utils.CopyGenericMaps(booleanPropertySchema, DataSourceMetadataProperties())
+	return schema.MapNestedAttribute{
+		MarkdownDescription: "The boolean property of the blueprint",
+		Computed:            true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: booleanPropertySchema,
+		},
+	}
+}
+
+func DataSourceArrayPropertySchema() schema.Attribute {
+	arrayPropertySchema := map[string]schema.Attribute{
+		"min_items": schema.Int64Attribute{
+			MarkdownDescription: "The min items of the array property",
+			Computed:            true,
+		},
+		"max_items": schema.Int64Attribute{
+			MarkdownDescription: "The max items of the array property",
+			Computed:            true,
+		},
+		"string_items": schema.SingleNestedAttribute{
+			MarkdownDescription: "The items of the array property",
+			Computed:            true,
+			Attributes: map[string]schema.Attribute{
+				"format": schema.StringAttribute{
+					MarkdownDescription: "The format of the items",
+					Computed:            true,
+				},
+				"default": schema.ListAttribute{
+					MarkdownDescription: "The default of the items",
+					Computed:            true,
+					ElementType:         types.StringType,
+				},
+				"pattern": schema.StringAttribute{
+					MarkdownDescription: "The pattern of the string array items",
+					Computed:            true,
+				},
+				"enum": schema.ListAttribute{
+					MarkdownDescription: "The enum of the string array items",
+					Computed:            true,
+					ElementType:         types.StringType,
+				},
+				"enum_colors": schema.MapAttribute{
+					MarkdownDescription: "The enum colors of the string array items",
+					Computed:            true,
+					ElementType:         types.StringType,
+				},
+			},
+		},
+		"number_items": schema.SingleNestedAttribute{
+			MarkdownDescription: "The items of the array property",
+			Computed:            true,
+			Attributes: map[string]schema.Attribute{
+				"default": schema.ListAttribute{
+					MarkdownDescription: "The default of the items",
+					Computed:            true,
+					ElementType:         types.Float64Type,
+				},
+			},
+		},
+		"boolean_items": schema.SingleNestedAttribute{
+			MarkdownDescription: "The items of the array property",
+			Computed:            true,
+			Attributes: map[string]schema.Attribute{
+				"default": schema.ListAttribute{
+					MarkdownDescription: "The default of the items",
+					Computed:            true,
+					ElementType:         types.BoolType,
+				},
+			},
+		},
+		"object_items": schema.SingleNestedAttribute{
+			MarkdownDescription: "The items of the array property",
+			Computed:            true,
+			Attributes: map[string]schema.Attribute{
+				"format": schema.StringAttribute{
+					MarkdownDescription: "The format of the object items",
+					Computed:            true,
+				},
+				"default": schema.ListAttribute{
+					MarkdownDescription: "The default of the items",
+					Computed:            true,
+					ElementType:         types.StringType,
+				},
+			},
+		},
+	}
+
+	utils.CopyGenericMaps(arrayPropertySchema, DataSourceMetadataProperties())
+	return schema.MapNestedAttribute{
+		MarkdownDescription: "The array property of the blueprint",
+		Computed:            true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: arrayPropertySchema,
+		},
+	}
+}
+
+func DataSourceObjectPropertySchema() schema.Attribute {
+	objectPropertySchema := map[string]schema.Attribute{
+		"spec": schema.StringAttribute{
+			MarkdownDescription: "The spec of the object property",
+			Computed:            true,
+		},
+		"format": schema.StringAttribute{
+			MarkdownDescription: "The format of the object property",
+			Computed:            true,
+		},
+		"default": schema.StringAttribute{
+			MarkdownDescription: "The default of the object property",
+			Computed:            true,
+		},
+	}
+
+	utils.CopyGenericMaps(objectPropertySchema, DataSourceMetadataProperties())
+	return schema.MapNestedAttribute{
+		MarkdownDescription: "The object property of the blueprint",
+		Computed:            true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: objectPropertySchema,
+		},
+	}
+}
+
+// DataSourceBlueprintAttributes returns the computed attributes shared by the blueprint data sources. They mirror
+// the attributes of the blueprint resource, so values read through a data source can be used interchangeably.
+func DataSourceBlueprintAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"title": schema.StringAttribute{
+			MarkdownDescription: "The display name of the blueprint",
+			Computed:            true,
+		},
+		"icon": schema.StringAttribute{
+			MarkdownDescription: "The icon of the blueprint",
+			Computed:            true,
+		},
+		"description": schema.StringAttribute{
+			MarkdownDescription: "The description of the blueprint",
+			Computed:            true,
+		},
+		"created_at": schema.StringAttribute{
+			MarkdownDescription: "The creation date of the blueprint",
+			Computed:            true,
+		},
+		"created_by": schema.StringAttribute{
+			MarkdownDescription: "The creator of the blueprint",
+			Computed:            true,
+		},
+		"updated_at": schema.StringAttribute{
+			MarkdownDescription: "The last update date of the blueprint",
+			Computed:            true,
+		},
+		"updated_by": schema.StringAttribute{
+			MarkdownDescription: "The last updater of the blueprint",
+			Computed:            true,
+		},
+		"team_inheritance": schema.SingleNestedAttribute{
+			MarkdownDescription: "The team inheritance of the blueprint",
+			Computed:            true,
+			Attributes: map[string]schema.Attribute{
+				"path": schema.StringAttribute{
+					MarkdownDescription: "The path of the team inheritance",
+					Computed:            true,
+				},
+			},
+		},
+		"webhook_changelog_destination": schema.SingleNestedAttribute{
+			MarkdownDescription: "The webhook changelog destination of the blueprint",
+			Computed:            true,
+			Attributes: map[string]schema.Attribute{
+				"url": schema.StringAttribute{
+					MarkdownDescription: "The url of the webhook changelog destination",
+					Computed:            true,
+				},
+				"agent": schema.BoolAttribute{
+					MarkdownDescription: "The agent of the webhook changelog destination",
+					Computed:            true,
+				},
+			},
+		},
+		"kafka_changelog_destination": schema.ObjectAttribute{
+			MarkdownDescription: "The changelog destination of the blueprint",
+			Computed:            true,
+			AttributeTypes:      map[string]attr.Type{},
+		},
+		"properties": schema.SingleNestedAttribute{
+			MarkdownDescription: "The properties of the blueprint",
+			Computed:            true,
+			Attributes: map[string]schema.Attribute{
+				"string_props":  DataSourceStringPropertySchema(),
+				"number_props":  DataSourceNumberPropertySchema(),
+				"boolean_props": DataSourceBooleanPropertySchema(),
+				"array_props":   DataSourceArrayPropertySchema(),
+				"object_props":  DataSourceObjectPropertySchema(),
+			},
+		},
+		"relations": schema.MapNestedAttribute{
+			MarkdownDescription: "The relations of the blueprint",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"title": schema.StringAttribute{
+						MarkdownDescription: "The title of the relation",
+						Computed:            true,
+					},
+					"description": schema.StringAttribute{
+						MarkdownDescription: "The description of the relation",
+						Computed:            true,
+					},
+					"target": schema.StringAttribute{
+						MarkdownDescription: "The target of the relation",
+						Computed:            true,
+					},
+					"many": schema.BoolAttribute{
+						MarkdownDescription: "The many of the relation",
+						Computed:            true,
+					},
+					"required": schema.BoolAttribute{
+						MarkdownDescription: "The required of the relation",
+						Computed:            true,
+					},
+				},
+			},
+		},
+		"mirror_properties": schema.MapNestedAttribute{
+			MarkdownDescription: "The mirror properties of the blueprint",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"path": schema.StringAttribute{
+						MarkdownDescription: "The path of the mirror property",
+						Computed:            true,
+					},
+					"title": schema.StringAttribute{
+						MarkdownDescription: "The title of the mirror property",
+						Computed:            true,
+					},
+				},
+			},
+		},
+		"calculation_properties": schema.MapNestedAttribute{
+			MarkdownDescription: "The calculation properties of the blueprint",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"calculation": schema.StringAttribute{
+						MarkdownDescription: "The calculation of the calculation property",
+						Computed:            true,
+					},
+					"type": schema.StringAttribute{
+						MarkdownDescription: "The type of the calculation property",
+						Computed:            true,
+					},
+					"title": schema.StringAttribute{
+						MarkdownDescription: "The title of the calculation property",
+						Computed:            true,
+					},
+					"description": schema.StringAttribute{
+						MarkdownDescription: "The description of the calculation property",
+						Computed:            true,
+					},
+					"icon": schema.StringAttribute{
+						MarkdownDescription: "The icon of the calculation property",
+						Computed:            true,
+					},
+					"format": schema.StringAttribute{
+						MarkdownDescription: "The format of the calculation property",
+						Computed:            true,
+					},
+					"date_format": schema.StringAttribute{
+						MarkdownDescription: "Display format for `date-time` calculation properties (for example `24-hour`)",
+						Computed:            true,
+					},
+					"colorized": schema.BoolAttribute{
+						MarkdownDescription: "The colorized of the calculation property",
+						Computed:            true,
+					},
+					"colors": schema.MapAttribute{
+						MarkdownDescription: "The colors of the calculation property",
+						Computed:            true,
+						ElementType:         types.StringType,
+					},
+					"spec": schema.StringAttribute{
+						MarkdownDescription: "The spec of the calculation property",
+						Computed:            true,
+					},
+					"spec_authentication": DataSourceSpecAuthenticationSchema("The spec authentication of the calculation property"),
+				},
+			},
+		},
+		"ownership": schema.SingleNestedAttribute{
+			MarkdownDescription: "The ownership of the blueprint",
+			Computed:            true,
+			Attributes: map[string]schema.Attribute{
+				"type": schema.StringAttribute{
+					MarkdownDescription: "Ownership type: either 'Inherited' or 'Direct'.",
+					Computed:            true,
+				},
+				"path": schema.StringAttribute{
+					MarkdownDescription: "Path for the Inherited ownership type.",
+					Computed:            true,
+				},
+				"title": schema.StringAttribute{
+					MarkdownDescription: "Title for the owning teams property.",
+					Computed:            true,
+				},
+			},
+		},
+		"include_in_global_search": schema.BoolAttribute{
+			MarkdownDescription: "Whether this blueprint's entities are included in global search (Spotlight)",
+			Computed:            true,
+		},
+	}
+}
+
+func BlueprintDataSourceSchema() map[string]schema.Attribute {
+	blueprintDataSourceSchema := map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed: true,
+		},
+		"identifier": schema.StringAttribute{
+			MarkdownDescription: "The identifier of the blueprint",
+			Required:            true,
+		},
+	}
+
+	utils.CopyGenericMaps(blueprintDataSourceSchema, DataSourceBlueprintAttributes())
+	return blueprintDataSourceSchema
+}
+
+func (d *BlueprintDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: BlueprintDataSourceMarkdownDescription,
+		Attributes:          BlueprintDataSourceSchema(),
+	}
+}
+
+var BlueprintDataSourceMarkdownDescription = `
+
+# Blueprint Data Source
+
+The blueprint data source allows you to read an existing blueprint in Port without managing it.
+
+The attributes of the data source have the same shape as the ` + "`port_blueprint`" + ` resource, so properties, relations,
+mirror properties and calculation properties can be referenced in the same way.
+
+## Example Usage
+
+` + "```hcl" + `
+
+data "port_blueprint" "environment" {
+  identifier = "environment"
+}
+
+resource "port_blueprint" "microservice" {
+  title      = "Microservice"
+  icon       = "Microservice"
+  identifier = "microservice"
+  relations = {
+    "environment" = {
+      target = data.port_blueprint.environment.identifier
+    }
+  }
+  mirror_properties = {
+    for identifier, prop in data.port_blueprint.environment.properties.string_props :
+    "environment_${identifier}" => {
+      title = prop.title
+      path  = "environment.${identifier}"
+    }
+  }
+}
+
+` + "```" + ``
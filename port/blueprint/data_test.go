@@ -0,0 +1,174 @@
+package blueprint_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/acctest"
+)
+
+func TestAccPortBlueprintDataSource(t *testing.T) {
+	environmentIdentifier := acctest.GenID(t)
+	identifier := acctest.GenID(t)
+	var testAccBlueprintConfig = fmt.Sprintf(`
+	resource "port_blueprint" "environment" {
+		title = "TF Provider Test Environment"
+		icon = "Terraform"
+		identifier = "%s"
+		properties = {
+			string_props = {
+				region = {
+					title = "Region"
+				}
+			}
+		}
+	}
+	resource "port_blueprint" "microservice" {
+		title = "TF Provider Test"
+		icon = "Terraform"
+		identifier = "%s"
+		description = "Owned by another team"
+		properties = {
+			string_props = {
+				myStringIdentifier = {
+					title = "My String"
+					required = true
+					enum = ["a", "b"]
+				}
+			}
+			number_props = {
+				myNumberIdentifier = {
+					title = "My Number"
+					minimum = 1
+				}
+			}
+			array_props = {
+				myStringArrayIdentifier = {
+					title = "My String Array"
+					string_items = {
+						default = ["a"]
+					}
+				}
+			}
+		}
+		relations = {
+			environment = {
+				title = "Environment"
+				target = port_blueprint.environment.identifier
+			}
+		}
+		mirror_properties = {
+			region = {
+				title = "Region"
+				path = "environment.region"
+			}
+		}
+		calculation_properties = {
+			upperTitle = {
+				title = "Upper Title"
+				calculation = ".title | ascii_upcase"
+				type = "string"
+			}
+		}
+	}
+`, environmentIdentifier, identifier)
+
+	var testAccBlueprintDataSourceConfig = `
+	data "port_blueprint" "microservice" {
+		identifier = port_blueprint.microservice.identifier
+	}
+`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig + testAccBlueprintConfig + testAccBlueprintDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.port_blueprint.microservice", "identifier", identifier),
+					resource.TestCheckResourceAttr("data.port_blueprint.microservice", "title", "TF Provider Test"),
+					resource.TestCheckResourceAttr("data.port_blueprint.microservice", "icon", "Terraform"),
+					resource.TestCheckResourceAttr("data.port_blueprint.microservice", "description", "Owned by another team"),
+					resource.TestCheckResourceAttr("data.port_blueprint.microservice", "properties.string_props.myStringIdentifier.title", "My String"),
+					resource.TestCheckResourceAttr("data.port_blueprint.microservice", "properties.string_props.myStringIdentifier.required", "true"),
+					resource.TestCheckResourceAttr("data.port_blueprint.microservice", "properties.string_props.myStringIdentifier.enum.0", "a"),
+					resource.TestCheckResourceAttr("data.port_blueprint.microservice", "properties.string_props.myStringIdentifier.enum.1", "b"),
+					resource.TestCheckResourceAttr("data.port_blueprint.microservice", "properties.number_props.myNumberIdentifier.minimum", "1"),
+					resource.TestCheckResourceAttr("data.port_blueprint.microservice", "properties.array_props.myStringArrayIdentifier.string_items.default.0", "a"),
+					resource.TestCheckResourceAttr("data.port_blueprint.microservice", "relations.environment.title", "Environment"),
+					resource.TestCheckResourceAttr("data.port_blueprint.microservice", "relations.environment.target", environmentIdentifier),
+					resource.TestCheckResourceAttr("data.port_blueprint.microservice", "relations.environment.many", "false"),
+					resource.TestCheckResourceAttr("data.port_blueprint.microservice", "mirror_properties.region.path", "environment.region"),
+					resource.TestCheckResourceAttr("data.port_blueprint.microservice", "calculation_properties.upperTitle.calculation", ".title | ascii_upcase"),
+					resource.TestCheckResourceAttr("data.port_blueprint.microservice", "calculation_properties.upperTitle.type", "string"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPortBlueprintsDataSource(t *testing.T) {
+	teamIdentifier := acctest.GenID(t)
+	prefix := acctest.GenID(t)
+	var testAccBlueprintsConfig = fmt.Sprintf(`
+	resource "port_blueprint" "team" {
+		title = "TF Provider Test Team"
+		icon = "Terraform"
+		identifier = "%s"
+	}
+	resource "port_blueprint" "payments" {
+		title = "TF Provider Test Payments Service"
+		icon = "Terraform"
+		identifier = "%s-payments"
+		relations = {
+			team = {
+				target = port_blueprint.team.identifier
+			}
+		}
+	}
+	resource "port_blueprint" "ads" {
+		title = "TF Provider Test Ads Service"
+		icon = "Terraform"
+		identifier = "%s-ads"
+	}
+`, teamIdentifier, prefix, prefix)
+
+	var testAccBlueprintsDataSourceConfig = fmt.Sprintf(`
+	data "port_blueprints" "by_prefix" {
+		identifier_prefix = "%s"
+		depends_on = [port_blueprint.payments, port_blueprint.ads]
+	}
+	data "port_blueprints" "by_relation_target" {
+		identifier_prefix = "%s"
+		relation_target = port_blueprint.team.identifier
+		depends_on = [port_blueprint.payments, port_blueprint.ads]
+	}
+	data "port_blueprints" "by_title" {
+		identifier_prefix = "%s"
+		title_regex = "Ads Service$"
+		depends_on = [port_blueprint.payments, port_blueprint.ads]
+	}
+`, prefix, prefix, prefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig + testAccBlueprintsConfig + testAccBlueprintsDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.port_blueprints.by_prefix", "blueprints.#", "2"),
+					resource.TestCheckResourceAttr("data.port_blueprints.by_prefix", "blueprints.0.identifier", fmt.Sprintf("%s-ads", prefix)),
+					resource.TestCheckResourceAttr("data.port_blueprints.by_prefix", "blueprints.1.identifier", fmt.Sprintf("%s-payments", prefix)),
+					resource.TestCheckResourceAttr("data.port_blueprints.by_relation_target", "blueprints.#", "1"),
+					resource.TestCheckResourceAttr("data.port_blueprints.by_relation_target", "blueprints.0.identifier", fmt.Sprintf("%s-payments", prefix)),
+					resource.TestCheckResourceAttr("data.port_blueprints.by_relation_target", "blueprints.0.relations.team.target", teamIdentifier),
+					resource.TestCheckResourceAttr("data.port_blueprints.by_title", "blueprints.#", "1"),
+					resource.TestCheckResourceAttr("data.port_blueprints.by_title", "blueprints.0.title", "TF Provider Test Ads Service"),
+				),
+			},
+		},
+	})
+}
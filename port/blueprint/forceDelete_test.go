@@ -0,0 +1,82 @@
+package blueprint
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/acctest/fakeclient"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestForceDeleteBlueprint(t *testing.T) {
+	ctx := context.Background()
+	server, client := fakeclient.New(t)
+
+	forceDelete := func(t *testing.T, identifier string, timeout time.Duration) *resource.DeleteResponse {
+		_, err := client.CreateBlueprint(ctx, &cli.Blueprint{Identifier: identifier, Title: identifier}, nil)
+		require.NoError(t, err)
+
+		deleteCtx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+		resp := &resource.DeleteResponse{}
+		forceDeleteBlueprint(deleteCtx, client, &BlueprintModel{Identifier: types.StringValue(identifier)}, timeout, resp)
+		return resp
+	}
+
+	t.Run("completed", func(t *testing.T) {
+		server.SetMigrationStatus("COMPLETED")
+		resp := forceDelete(t, "completed", time.Minute)
+		require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+		_, statusCode, err := client.ReadBlueprint(ctx, "completed")
+		require.Error(t, err)
+		assert.Equal(t, 404, statusCode)
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		server.SetMigrationStatus("RUNNING")
+		start := time.Now()
+		resp := forceDelete(t, "running", time.Second)
+		assert.Less(t, time.Since(start), 5*time.Second, "polling stops at the deadline instead of waiting for the next poll")
+
+		require.Len(t, resp.Diagnostics.Errors(), 1)
+		diagnostic := resp.Diagnostics.Errors()[0]
+		assert.Equal(t, "timed out waiting for the deletion of blueprint running", diagnostic.Summary())
+		assert.Regexp(t, `Migration migration_\d+, .* didn't finish within 1s, its last status is RUNNING`, diagnostic.Detail())
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		server.SetMigrationStatus("FAILURE")
+		resp := forceDelete(t, "failure", time.Minute)
+
+		require.Len(t, resp.Diagnostics.Errors(), 1)
+		assert.Regexp(t, `^migration migration_\d+ failed$`, resp.Diagnostics.Errors()[0].Detail())
+	})
+}
+
+func TestMigrationLogFields(t *testing.T) {
+	successCount, failureCount := 3, 0
+	assert.Equal(t, map[string]interface{}{
+		"migration_id":  "migration_1",
+		"status":        "RUNNING",
+		"elapsed":       "12s",
+		"success_count": 3,
+		"failure_count": 0,
+	}, migrationLogFields(&cli.Migration{
+		Id:           "migration_1",
+		Status:       "RUNNING",
+		SuccessCount: &successCount,
+		FailureCount: &failureCount,
+	}, 12300*time.Millisecond))
+
+	assert.Equal(t, map[string]interface{}{
+		"migration_id": "migration_1",
+		"status":       "PENDING",
+		"elapsed":      "0s",
+	}, migrationLogFields(&cli.Migration{Id: "migration_1", Status: "PENDING"}, 0), "counts the API doesn't return aren't logged")
+}
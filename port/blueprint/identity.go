@@ -0,0 +1,44 @@
+package blueprint
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.ResourceWithIdentity = &BlueprintResource{}
+
+type BlueprintIdentityModel struct {
+	Identifier types.String `tfsdk:"identifier"`
+}
+
+func (r *BlueprintResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"identifier": identityschema.StringAttribute{
+				Description:       "The identifier of the blueprint",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func blueprintIdentity(identifier string) *BlueprintIdentityModel {
+	return &BlueprintIdentityModel{Identifier: types.StringValue(identifier)}
+}
+
+// importStateFromIdentity imports a blueprint by the identity Terraform passes when the import block sets `identity`
+// instead of `id`, e.g. in the configuration generated by `terraform query`.
+func importStateFromIdentity(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var identity *BlueprintIdentityModel
+	resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("identifier"), identity.Identifier)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), identity.Identifier)...)
+}
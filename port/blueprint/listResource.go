@@ -0,0 +1,84 @@
+package blueprint
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/flex"
+)
+
+var _ list.ListResourceWithConfigure = &BlueprintListResource{}
+
+func NewBlueprintListResource() list.ListResource {
+	return &BlueprintListResource{}
+}
+
+// BlueprintListResource lists the blueprints of the organization for `terraform query`. System blueprints, whose
+// identifiers start with `_`, are managed with port_system_blueprint and aren't listed.
+type BlueprintListResource struct {
+	portClient *cli.PortClient
+}
+
+func (r *BlueprintListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_blueprint"
+}
+
+func (r *BlueprintListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.portClient = req.ProviderData.(*cli.PortClient)
+}
+
+func (r *BlueprintListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the blueprints of the organization",
+	}
+}
+
+func (r *BlueprintListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	blueprints, err := r.portClient.ReadBlueprints(ctx)
+	if err != nil {
+		var diags diag.Diagnostics
+		diags.AddError("failed to read blueprints", err.Error())
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	blueprintResource := &BlueprintResource{portClient: r.portClient}
+	stream.Results = func(push func(list.ListResult) bool) {
+		var count int64
+		for _, b := range blueprints {
+			if strings.HasPrefix(b.Identifier, "_") {
+				continue
+			}
+			if req.Limit > 0 && count >= req.Limit {
+				return
+			}
+			count++
+
+			result := req.NewListResult(ctx)
+			result.DisplayName = b.Title
+			result.Diagnostics.Append(result.Identity.Set(ctx, blueprintIdentity(b.Identifier))...)
+
+			if req.IncludeResource && !result.Diagnostics.HasError() {
+				state := &BlueprintModel{Timeouts: flex.NullTimeouts(ctx, timeoutsOpts)}
+				if err := blueprintResource.refreshBlueprintState(ctx, state, &b); err != nil {
+					result.Diagnostics.AddError("failed writing blueprint fields to resource", err.Error())
+				} else {
+					result.Diagnostics.Append(result.Resource.Set(ctx, state)...)
+				}
+			}
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
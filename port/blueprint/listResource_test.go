@@ -0,0 +1,26 @@
+package blueprint_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/acctest"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/acctest/fakeclient"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPortBlueprintList(t *testing.T) {
+	ctx := context.Background()
+	server, client := fakeclient.New(t)
+	_, err := client.CreateBlueprint(ctx, &cli.Blueprint{Identifier: "microservice", Title: "Microservice"}, nil)
+	require.NoError(t, err)
+
+	results := acctest.List(t, server, "port_blueprint", nil, true)
+	require.Len(t, results, 1, "system blueprints aren't listed")
+	assert.Equal(t, "Microservice", results[0].DisplayName)
+	assert.Equal(t, tftypes.NewValue(tftypes.String, "microservice"), results[0].Identity["identifier"])
+	assert.Equal(t, tftypes.NewValue(tftypes.String, "Microservice"), results[0].Resource["title"])
+}
@@ -1,6 +1,7 @@
 package blueprint
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -193,4 +194,34 @@ type BlueprintModel struct {
 	CreateCatalogPage           types.Bool                          `tfsdk:"create_catalog_page"`
 	Ownership                   *OwnershipModel                     `tfsdk:"ownership"`
 	IncludeInGlobalSearch       types.Bool                          `tfsdk:"include_in_global_search"`
+	Timeouts                    timeouts.Value                      `tfsdk:"timeouts"`
+}
+
+type BlueprintDataSourceModel struct {
+	ID                          types.String                        `tfsdk:"id"`
+	Identifier                  types.String                        `tfsdk:"identifier"`
+	Title                       types.String                        `tfsdk:"title"`
+	Icon                        types.String                        `tfsdk:"icon"`
+	Description                 types.String                        `tfsdk:"description"`
+	CreatedAt                   types.String                        `tfsdk:"created_at"`
+	CreatedBy                   types.String                        `tfsdk:"created_by"`
+	UpdatedAt                   types.String                        `tfsdk:"updated_at"`
+	UpdatedBy                   types.String                        `tfsdk:"updated_by"`
+	KafkaChangelogDestination   types.Object                        `tfsdk:"kafka_changelog_destination"`
+	WebhookChangelogDestination *WebhookChangelogDestinationModel   `tfsdk:"webhook_changelog_destination"`
+	TeamInheritance             *TeamInheritanceModel               `tfsdk:"team_inheritance"`
+	Properties                  *PropertiesModel                    `tfsdk:"properties"`
+	Relations                   map[string]RelationModel            `tfsdk:"relations"`
+	MirrorProperties            map[string]MirrorPropertyModel      `tfsdk:"mirror_properties"`
+	CalculationProperties       map[string]CalculationPropertyModel `tfsdk:"calculation_properties"`
+	Ownership                   *OwnershipModel                     `tfsdk:"ownership"`
+	IncludeInGlobalSearch       types.Bool                          `tfsdk:"include_in_global_search"`
+}
+
+type BlueprintsDataSourceModel struct {
+	ID               types.String               `tfsdk:"id"`
+	IdentifierPrefix types.String               `tfsdk:"identifier_prefix"`
+	TitleRegex       types.String               `tfsdk:"title_regex"`
+	RelationTarget   types.String               `tfsdk:"relation_target"`
+	Blueprints       []BlueprintDataSourceModel `tfsdk:"blueprints"`
 }
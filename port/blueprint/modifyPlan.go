@@ -0,0 +1,210 @@
+package blueprint
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/utils"
+)
+
+var _ resource.ResourceWithModifyPlan = &BlueprintResource{}
+
+// entityCountLimit caps the entities searched to count them, so planning doesn't download every entity of large
+// blueprints. Counts that reach it are reported as a lower bound.
+const entityCountLimit = 100
+
+// ModifyPlan warns about destroys and property removals that fail or lose data because of entities in Port, which
+// Delete and Update would otherwise only find out about mid-apply.
+func (r *BlueprintResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || r.portClient == nil {
+		return
+	}
+
+	var identifier types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("identifier"), &identifier)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if req.Plan.Raw.IsNull() {
+		var forceDeleteEntities types.Bool
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("force_delete_entities"), &forceDeleteEntities)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		resp.Diagnostics.Append(r.destroyWarnings(ctx, identifier.ValueString(), forceDeleteEntities.ValueBool())...)
+		return
+	}
+
+	var stateProperties, planProperties *PropertiesModel
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("properties"), &stateProperties)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// The planned properties can't be compared while they're unknown.
+	if diags := req.Plan.GetAttribute(ctx, path.Root("properties"), &planProperties); diags.HasError() {
+		return
+	}
+	removed := removedProperties(stateProperties, planProperties)
+	resp.Diagnostics.Append(r.propertyRemovalWarnings(ctx, identifier.ValueString(), removed)...)
+}
+
+func (r *BlueprintResource) destroyWarnings(ctx context.Context, identifier string, forceDeleteEntities bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	entities, err := r.portClient.Search(ctx, &cli.SearchRequestQuery{
+		Query: &map[string]any{
+			"combinator": "and",
+			"rules":      []any{map[string]any{"property": "$blueprint", "operator": "=", "value": identifier}},
+		},
+		Include:    []string{"identifier"},
+		MaxResults: utils.PtrTo(entityCountLimit),
+	})
+	if err != nil {
+		diags.AddWarning(fmt.Sprintf("Failed to count the entities of blueprint %s", identifier), err.Error())
+		return diags
+	}
+	if count := len(entities.Entities); count > 0 {
+		if forceDeleteEntities {
+			diags.AddWarning(fmt.Sprintf("Destroying blueprint %s deletes %s entities", identifier, entityCount(count, entities.Truncated)),
+				"force_delete_entities is set, so all the entities of the blueprint are deleted with it, including the ones Terraform doesn't manage.")
+		} else {
+			diags.AddWarning(fmt.Sprintf("Blueprint %s has %s entities", identifier, entityCount(count, entities.Truncated)),
+				"Destroying the blueprint fails unless all its entities are destroyed first. Destroy them in the same plan, or set force_delete_entities to true to delete them with the blueprint.")
+		}
+	}
+
+	inbound, err := r.inboundRelations(ctx, identifier)
+	if err != nil {
+		diags.AddWarning(fmt.Sprintf("Failed to count the relations to blueprint %s", identifier), err.Error())
+		return diags
+	}
+	if len(inbound) > 0 {
+		var lines []string
+		for _, relation := range inbound {
+			lines = append(lines, fmt.Sprintf("- %s: %s entities relate to blueprint %s", relation.name, entityCount(relation.entities, relation.truncated), identifier))
+		}
+		diags.AddWarning(fmt.Sprintf("Blueprint %s is the target of %d relations", identifier, len(inbound)),
+			"Destroying the blueprint fails unless these relations are removed first:\n"+strings.Join(lines, "\n"))
+	}
+	return diags
+}
+
+type inboundRelation struct {
+	// name is the relation, in the form `blueprint.relation`.
+	name     string
+	entities int
+	// truncated is set when only some of the entities of the blueprint were searched, so entities is a lower bound.
+	truncated bool
+}
+
+// inboundRelations returns the relations of other blueprints that target the blueprint and that some of their entities
+// set, with the number of these entities up to entityCountLimit.
+func (r *BlueprintResource) inboundRelations(ctx context.Context, identifier string) ([]inboundRelation, error) {
+	blueprints, err := r.portClient.ReadBlueprints(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var inbound []inboundRelation
+	for _, b := range blueprints {
+		if b.Identifier == identifier {
+			continue
+		}
+		for _, relationID := range slices.Sorted(maps.Keys(b.Relations)) {
+			relation := b.Relations[relationID]
+			if relation.Target == nil || *relation.Target != identifier {
+				continue
+			}
+			entities, err := r.portClient.Search(ctx, &cli.SearchRequestQuery{
+				Query: &map[string]any{
+					"combinator": "and",
+					"rules": []any{
+						map[string]any{"property": "$blueprint", "operator": "=", "value": b.Identifier},
+						map[string]any{"property": relationID, "operator": "isNotEmpty"},
+					},
+				},
+				Include:    []string{"identifier"},
+				MaxResults: utils.PtrTo(entityCountLimit),
+			})
+			if err != nil {
+				return nil, err
+			}
+			if len(entities.Entities) == 0 {
+				continue
+			}
+			inbound = append(inbound, inboundRelation{name: fmt.Sprintf("%s.%s", b.Identifier, relationID), entities: len(entities.Entities), truncated: entities.Truncated})
+		}
+	}
+	return inbound, nil
+}
+
+// entityCount formats a count of entities, which is a lower bound when the search was truncated.
+func entityCount(count int, truncated bool) string {
+	if truncated {
+		return fmt.Sprintf("at least %d", count)
+	}
+	return strconv.Itoa(count)
+}
+
+func (r *BlueprintResource) propertyRemovalWarnings(ctx context.Context, identifier string, removed []string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	for _, property := range removed {
+		entities, err := r.portClient.Search(ctx, &cli.SearchRequestQuery{
+			Query: &map[string]any{
+				"combinator": "and",
+				"rules": []any{
+					map[string]any{"property": "$blueprint", "operator": "=", "value": identifier},
+					map[string]any{"property": property, "operator": "isNotEmpty"},
+				},
+			},
+			Include:    []string{"identifier"},
+			MaxResults: utils.PtrTo(entityCountLimit),
+		})
+		if err != nil {
+			diags.AddWarning(fmt.Sprintf("Failed to count the entities that set property %s of blueprint %s", property, identifier), err.Error())
+			continue
+		}
+		if count := len(entities.Entities); count > 0 {
+			diags.AddAttributeWarning(path.Root("properties"),
+				fmt.Sprintf("Removing property %s of blueprint %s deletes its value from %s entities", property, identifier, entityCount(count, entities.Truncated)),
+				"The entities of the blueprint that set the property lose its value when it's removed.")
+		}
+	}
+	return diags
+}
+
+// removedProperties returns the identifiers of the properties that are in the state but no longer in the plan.
+func removedProperties(state *PropertiesModel, plan *PropertiesModel) []string {
+	planned := propertyIdentifiers(plan)
+	var removed []string
+	for _, identifier := range propertyIdentifiers(state) {
+		if !slices.Contains(planned, identifier) {
+			removed = append(removed, identifier)
+		}
+	}
+	return removed
+}
+
+func propertyIdentifiers(properties *PropertiesModel) []string {
+	if properties == nil {
+		return nil
+	}
+	var identifiers []string
+	identifiers = append(identifiers, slices.Collect(maps.Keys(properties.StringProps))...)
+	identifiers = append(identifiers, slices.Collect(maps.Keys(properties.NumberProps))...)
+	identifiers = append(identifiers, slices.Collect(maps.Keys(properties.BooleanProps))...)
+	identifiers = append(identifiers, slices.Collect(maps.Keys(properties.ArrayProps))...)
+	identifiers = append(identifiers, slices.Collect(maps.Keys(properties.ObjectProps))...)
+	slices.Sort(identifiers)
+	return identifiers
+}
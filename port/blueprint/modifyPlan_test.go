@@ -0,0 +1,170 @@
+package blueprint
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/acctest/fakeclient"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func summaries(diags diag.Diagnostics) []string {
+	return utils.Map(diags, func(d diag.Diagnostic) string { return d.Summary() })
+}
+
+func TestBlueprintModifyPlanWarnings(t *testing.T) {
+	ctx := context.Background()
+	_, client := fakeclient.New(t)
+
+	_, err := client.CreateBlueprint(ctx, &cli.Blueprint{
+		Identifier: "team",
+		Title:      "Team",
+		Schema: cli.BlueprintSchema{Properties: map[string]cli.BlueprintProperty{
+			"slack": {Type: "string"},
+			"size":  {Type: "number"},
+		}},
+	}, nil)
+	require.NoError(t, err)
+	_, err = client.CreateBlueprint(ctx, &cli.Blueprint{
+		Identifier: "service",
+		Title:      "Service",
+		Relations:  map[string]cli.Relation{"owner": {Target: utils.PtrTo("team")}},
+	}, nil)
+	require.NoError(t, err)
+	// No repository sets its maintainer, so the relation isn't reported.
+	_, err = client.CreateBlueprint(ctx, &cli.Blueprint{
+		Identifier: "repository",
+		Title:      "Repository",
+		Relations:  map[string]cli.Relation{"maintainer": {Target: utils.PtrTo("team")}},
+	}, nil)
+	require.NoError(t, err)
+	for _, e := range []cli.Entity{
+		{Identifier: "platform", Blueprint: "team", Properties: map[string]any{"slack": "#platform"}},
+		{Identifier: "payments", Blueprint: "team"},
+		{Identifier: "api", Blueprint: "service", Relations: map[string]any{"owner": "platform"}},
+		{Identifier: "web", Blueprint: "service"},
+		{Identifier: "monorepo", Blueprint: "repository"},
+	} {
+		_, err = client.CreateEntity(ctx, &e, "", false)
+		require.NoError(t, err)
+	}
+
+	r := &BlueprintResource{portClient: client}
+
+	diags := r.destroyWarnings(ctx, "team", false)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, []string{"Blueprint team has 2 entities", "Blueprint team is the target of 1 relations"}, summaries(diags))
+	assert.Contains(t, diags[1].Detail(), "- service.owner: 1 entities relate to blueprint team")
+
+	diags = r.destroyWarnings(ctx, "team", true)
+	assert.Equal(t, "Destroying blueprint team deletes 2 entities", diags[0].Summary())
+
+	assert.Empty(t, r.destroyWarnings(ctx, "service", false)[1:], "no blueprint relates to service")
+
+	removed := removedProperties(
+		&PropertiesModel{StringProps: map[string]StringPropModel{"slack": {}}, NumberProps: map[string]NumberPropModel{"size": {}}},
+		&PropertiesModel{NumberProps: map[string]NumberPropModel{"size": {}}},
+	)
+	assert.Equal(t, []string{"slack"}, removed)
+	assert.Equal(t, []string{"size", "slack"}, removedProperties(&PropertiesModel{
+		StringProps: map[string]StringPropModel{"slack": {}}, NumberProps: map[string]NumberPropModel{"size": {}},
+	}, nil))
+
+	diags = r.propertyRemovalWarnings(ctx, "team", []string{"size", "slack"})
+	assert.Equal(t, []string{"Removing property slack of blueprint team deletes its value from 1 entities"}, summaries(diags),
+		"no entity sets the size")
+}
+
+func TestBlueprintModifyPlanCountLimit(t *testing.T) {
+	ctx := context.Background()
+	server, client := fakeclient.New(t)
+
+	_, err := client.CreateBlueprint(ctx, &cli.Blueprint{Identifier: "team", Title: "Team"}, nil)
+	require.NoError(t, err)
+	_, err = client.CreateBlueprint(ctx, &cli.Blueprint{
+		Identifier: "service",
+		Title:      "Service",
+		Relations:  map[string]cli.Relation{"owner": {Target: utils.PtrTo("team")}},
+	}, nil)
+	require.NoError(t, err)
+	entity := func(blueprint, identifier string, relations map[string]any) {
+		server.PutObject("entity", blueprint+"/"+identifier, map[string]any{
+			"identifier": identifier, "blueprint": blueprint, "properties": map[string]any{}, "relations": relations,
+		})
+	}
+	for i := range entityCountLimit + 1 {
+		entity("team", fmt.Sprintf("team-%03d", i), map[string]any{})
+	}
+	// Only the last services, past the first entityCountLimit ones, set their owner.
+	for i := range 2 * entityCountLimit {
+		relations := map[string]any{"owner": nil}
+		if i >= 2*entityCountLimit-3 {
+			relations["owner"] = "team-000"
+		}
+		entity("service", fmt.Sprintf("service-%03d", i), relations)
+	}
+
+	r := &BlueprintResource{portClient: client}
+	diags := r.destroyWarnings(ctx, "team", false)
+	assert.Equal(t, []string{"Blueprint team has at least 100 entities", "Blueprint team is the target of 1 relations"}, summaries(diags))
+	assert.Contains(t, diags[1].Detail(), "- service.owner: 3 entities relate to blueprint team")
+}
+
+func TestBlueprintModifyPlan(t *testing.T) {
+	ctx := context.Background()
+	_, client := fakeclient.New(t)
+
+	_, err := client.CreateBlueprint(ctx, &cli.Blueprint{
+		Identifier: "team",
+		Title:      "Team",
+		Schema:     cli.BlueprintSchema{Properties: map[string]cli.BlueprintProperty{"slack": {Type: "string"}}},
+	}, nil)
+	require.NoError(t, err)
+	_, err = client.CreateEntity(ctx, &cli.Entity{Identifier: "platform", Blueprint: "team", Properties: map[string]any{"slack": "#platform"}}, "", false)
+	require.NoError(t, err)
+
+	r := &BlueprintResource{portClient: client}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	require.False(t, schemaResp.Diagnostics.HasError(), schemaResp.Diagnostics)
+	nullRaw := tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)
+
+	// blueprintPlan returns a plan of blueprint team with the given string properties, which is also used as state.
+	blueprintPlan := func(t *testing.T, stringProps ...string) tfsdk.Plan {
+		plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: nullRaw}
+		require.False(t, plan.SetAttribute(ctx, path.Root("identifier"), "team").HasError())
+		require.False(t, plan.SetAttribute(ctx, path.Root("force_delete_entities"), false).HasError())
+		for _, p := range stringProps {
+			diags := plan.SetAttribute(ctx, path.Root("properties").AtName("string_props").AtMapKey(p).AtName("title"), types.StringValue(p))
+			require.False(t, diags.HasError(), diags)
+		}
+		return plan
+	}
+	modifyPlan := func(state tfsdk.Plan, plan tfsdk.Plan) diag.Diagnostics {
+		resp := &resource.ModifyPlanResponse{Plan: plan}
+		r.ModifyPlan(ctx, resource.ModifyPlanRequest{State: tfsdk.State(state), Plan: plan}, resp)
+		return resp.Diagnostics
+	}
+	nullPlan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: nullRaw}
+
+	assert.Empty(t, modifyPlan(nullPlan, blueprintPlan(t, "slack")), "creating the blueprint doesn't warn")
+	assert.Empty(t, modifyPlan(blueprintPlan(t, "slack"), blueprintPlan(t, "slack")), "keeping the property doesn't warn")
+
+	diags := modifyPlan(blueprintPlan(t, "slack"), blueprintPlan(t))
+	assert.False(t, diags.HasError(), diags)
+	assert.Equal(t, []string{"Removing property slack of blueprint team deletes its value from 1 entities"}, summaries(diags))
+
+	diags = modifyPlan(blueprintPlan(t, "slack"), nullPlan)
+	assert.False(t, diags.HasError(), diags)
+	assert.Equal(t, []string{"Blueprint team has 1 entities"}, summaries(diags))
+}
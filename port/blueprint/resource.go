@@ -2,6 +2,7 @@ package blueprint
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 	"time"
@@ -13,6 +14,7 @@ import (
 	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
 	"github.com/port-labs/terraform-provider-port-labs/v2/internal/consts"
 	"github.com/port-labs/terraform-provider-port-labs/v2/internal/flex"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/poll"
 )
 
 var _ resource.Resource = &BlueprintResource{}
@@ -28,6 +30,8 @@ type BlueprintResource struct {
 
 func (r *BlueprintResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_blueprint"
+	// Blueprints are renamed in place, so their identity can change.
+	resp.ResourceBehavior.MutableIdentity = true
 }
 
 func (r *BlueprintResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -47,6 +51,7 @@ func (r *BlueprintResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, blueprintIdentity(state.Identifier.ValueString()))...)
 	b, statusCode, err := r.portClient.ReadBlueprint(ctx, state.Identifier.ValueString())
 	if err != nil {
 		if statusCode == 404 {
@@ -151,6 +156,14 @@ func (r *BlueprintResource) Create(ctx context.Context, req resource.CreateReque
 		return
 	}
 
+	createTimeout, diags := state.Timeouts.Create(ctx, consts.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	b, err := blueprintResourceToPortRequest(ctx, state)
 
 	createCatalogPage := state.CreateCatalogPage.ValueBoolPointer()
@@ -169,6 +182,7 @@ func (r *BlueprintResource) Create(ctx context.Context, req resource.CreateReque
 	writeBlueprintComputedFieldsToState(state, bp)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, blueprintIdentity(bp.Identifier))...)
 }
 
 func writeBlueprintComputedFieldsToState(state *BlueprintModel, bp *cli.Blueprint) {
@@ -194,6 +208,14 @@ func (r *BlueprintResource) Update(ctx context.Context, req resource.UpdateReque
 		return
 	}
 
+	updateTimeout, diags := state.Timeouts.Update(ctx, consts.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	prevB, err := blueprintResourceToPortRequest(ctx, previousState)
 	if err != nil {
 		resp.Diagnostics.AddError("failed to transform previous state into a blueprint", err.Error())
@@ -270,6 +292,7 @@ func (r *BlueprintResource) Update(ctx context.Context, req resource.UpdateReque
 	writeBlueprintComputedFieldsToState(state, bp)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, blueprintIdentity(bp.Identifier))...)
 
 }
 
@@ -286,6 +309,14 @@ func (r *BlueprintResource) Delete(ctx context.Context, req resource.DeleteReque
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, consts.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	// if deletion protection is not set, this means that the user destroyed the resource, right after upgrading to a version that supports deletion protection
 	// therefor we want to be backwards compatible and assume that the user want to have deletion protection
 	forceDeleteEntities := state.ForceDeleteEntities.ValueBool()
@@ -301,12 +332,16 @@ func (r *BlueprintResource) Delete(ctx context.Context, req resource.DeleteReque
 			return
 		}
 	} else {
-		forceDeleteBlueprint(ctx, r.portClient, state, resp)
+		forceDeleteBlueprint(ctx, r.portClient, state, deleteTimeout, resp)
 	}
 
 }
 
 func (r *BlueprintResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		importStateFromIdentity(ctx, req, resp)
+		return
+	}
 
 	resp.Diagnostics.Append(resp.State.SetAttribute(
 		ctx, path.Root("identifier"), req.ID,
@@ -317,39 +352,62 @@ func (r *BlueprintResource) ImportState(ctx context.Context, req resource.Import
 	)...)
 }
 
-func forceDeleteBlueprint(ctx context.Context, portClient *cli.PortClient, state *BlueprintModel, resp *resource.DeleteResponse) {
-	migrationId, err := portClient.DeleteBlueprintWithAllEntities(ctx, state.Identifier.ValueString())
+// forceDeleteBlueprint deletes the blueprint with all its entities, and waits for the migration that deletes them
+// until the context is done, which is when the delete timeout expires.
+func forceDeleteBlueprint(ctx context.Context, portClient *cli.PortClient, state *BlueprintModel, timeout time.Duration, resp *resource.DeleteResponse) {
+	identifier := state.Identifier.ValueString()
+	migrationId, err := portClient.DeleteBlueprintWithAllEntities(ctx, identifier)
 	if err != nil {
 		resp.Diagnostics.AddError("failed to delete blueprint", err.Error())
 		return
 	}
-	// query migration status until status is SUCCESS or FAILED
-	for {
+
+	start := time.Now()
+	status := consts.Pending
+	err = poll.Until(ctx, func(ctx context.Context) (bool, error) {
 		migration, err := portClient.GetMigration(ctx, *migrationId)
 		if err != nil {
-			resp.Diagnostics.AddError("failed to get migration status", err.Error())
-			return
-		}
-		if migration.Status == consts.Failure {
-			resp.Diagnostics.AddError("failed to delete blueprint", "migration failed")
-			return
+			return false, err
 		}
-		if migration.Status == consts.Cancelled {
-			resp.Diagnostics.AddError("failed to delete blueprint", "migration was cancelled")
-			return
-		}
-		if migration.Status == consts.Completed {
-			tflog.Info(ctx, "Migration completed successfully", map[string]interface{}{
-				"migration_id": migration.Id,
-			})
-			break
-		}
-		if err != nil {
-			resp.Diagnostics.AddError("failed to get migration status", err.Error())
-			return
-		}
-		time.Sleep(5 * time.Second)
+		status = migration.Status
+		tflog.Info(ctx, fmt.Sprintf("Deleting blueprint %s and its entities", identifier), migrationLogFields(migration, time.Since(start)))
+		return status == consts.Completed || status == consts.Failure || status == consts.Cancelled, nil
+	})
+	if errors.Is(err, poll.TimeoutError) {
+		resp.Diagnostics.AddError(fmt.Sprintf("timed out waiting for the deletion of blueprint %s", identifier),
+			fmt.Sprintf("Migration %s, which deletes the blueprint and its entities, didn't finish within %s, its last "+
+				"status is %s. The migration keeps running in Port, run the destroy again once it finishes, or raise the "+
+				"delete timeout in the timeouts block of the blueprint.", *migrationId, timeout, status))
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("failed to get migration status", fmt.Sprintf("failed to read migration %s: %s", *migrationId, err.Error()))
+		return
+	}
+
+	switch status {
+	case consts.Failure:
+		resp.Diagnostics.AddError("failed to delete blueprint", fmt.Sprintf("migration %s failed", *migrationId))
+	case consts.Cancelled:
+		resp.Diagnostics.AddError("failed to delete blueprint", fmt.Sprintf("migration %s was cancelled", *migrationId))
+	}
+}
+
+// migrationLogFields returns the progress of a migration for the logs. The entity counts are only included when the API
+// returns them.
+func migrationLogFields(migration *cli.Migration, elapsed time.Duration) map[string]interface{} {
+	fields := map[string]interface{}{
+		"migration_id": migration.Id,
+		"status":       migration.Status,
+		"elapsed":      elapsed.Round(time.Second).String(),
+	}
+	if migration.SuccessCount != nil {
+		fields["success_count"] = *migration.SuccessCount
+	}
+	if migration.FailureCount != nil {
+		fields["failure_count"] = *migration.FailureCount
 	}
+	return fields
 }
 
 func blueprintResourceToPortRequest(ctx context.Context, state *BlueprintModel) (*cli.Blueprint, error) {
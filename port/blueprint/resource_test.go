@@ -21,11 +21,10 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/port-labs/terraform-provider-port-labs/v2/internal/acctest"
-	"github.com/port-labs/terraform-provider-port-labs/v2/internal/utils"
 )
 
 func TestAccPortBlueprintBasic(t *testing.T) {
-	identifier := utils.GenID()
+	identifier := acctest.GenID(t)
 	var testAccActionConfigCreate = fmt.Sprintf(`
 	resource "port_blueprint" "microservice" {
 		title = "TF Provider Test"
@@ -36,7 +35,7 @@ func TestAccPortBlueprintBasic(t *testing.T) {
 `, identifier)
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: acctest.ProviderConfig + testAccActionConfigCreate,
@@ -52,7 +51,7 @@ func TestAccPortBlueprintBasic(t *testing.T) {
 }
 
 func TestAccPortBlueprintIncludeInGlobalSearch(t *testing.T) {
-	identifier := utils.GenID()
+	identifier := acctest.GenID(t)
 	var configTrue = fmt.Sprintf(`
 	resource "port_blueprint" "microservice" {
 		title = "TF Provider Test"
@@ -81,7 +80,7 @@ func TestAccPortBlueprintIncludeInGlobalSearch(t *testing.T) {
 `, identifier)
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: acctest.ProviderConfig + configTrue,
@@ -106,7 +105,7 @@ func TestAccPortBlueprintIncludeInGlobalSearch(t *testing.T) {
 }
 
 func TestAccPortBlueprintStringProperty(t *testing.T) {
-	identifier := utils.GenID()
+	identifier := acctest.GenID(t)
 	var testAccActionConfigCreate = fmt.Sprintf(`
 	resource "port_blueprint" "microservice" {
 		title = "TF Provider Test"
@@ -136,7 +135,7 @@ func TestAccPortBlueprintStringProperty(t *testing.T) {
 `, identifier)
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: acctest.ProviderConfig + testAccActionConfigCreate,
@@ -164,7 +163,7 @@ func TestAccPortBlueprintStringProperty(t *testing.T) {
 }
 
 func TestAccPortBlueprintNumberProperty(t *testing.T) {
-	identifier := utils.GenID()
+	identifier := acctest.GenID(t)
 	var testAccActionConfigCreate = fmt.Sprintf(`
 	resource "port_blueprint" "microservice" {
 		title = "TF Provider Test"
@@ -193,7 +192,7 @@ func TestAccPortBlueprintNumberProperty(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: acctest.ProviderConfig + testAccActionConfigCreate,
@@ -221,7 +220,7 @@ func TestAccPortBlueprintNumberProperty(t *testing.T) {
 }
 
 func TestAccPortBlueprintBooleanProperty(t *testing.T) {
-	identifier := utils.GenID()
+	identifier := acctest.GenID(t)
 	var testAccActionConfigCreate = fmt.Sprintf(`
 	resource "port_blueprint" "microservice" {
 		title = "TF Provider Test"
@@ -242,7 +241,7 @@ func TestAccPortBlueprintBooleanProperty(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: acctest.ProviderConfig + testAccActionConfigCreate,
@@ -262,7 +261,7 @@ func TestAccPortBlueprintBooleanProperty(t *testing.T) {
 }
 
 func TestAccPortBlueprintArrayProperty(t *testing.T) {
-	identifier := utils.GenID()
+	identifier := acctest.GenID(t)
 	var testAccActionConfigCreate = fmt.Sprintf(`
 	resource "port_blueprint" "microservice" {
 		title = "TF Provider Test"
@@ -319,7 +318,7 @@ func TestAccPortBlueprintArrayProperty(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: acctest.ProviderConfig + testAccActionConfigCreate,
@@ -355,7 +354,7 @@ func TestAccPortBlueprintArrayProperty(t *testing.T) {
 }
 
 func TestAccPortBlueprintObjectProperty(t *testing.T) {
-	identifier := utils.GenID()
+	identifier := acctest.GenID(t)
 	var testAccActionConfigCreate = fmt.Sprintf(`
 	resource "port_blueprint" "microservice" {
 		title = "TF Provider Test"
@@ -378,7 +377,7 @@ func TestAccPortBlueprintObjectProperty(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: acctest.ProviderConfig + testAccActionConfigCreate,
@@ -399,7 +398,7 @@ func TestAccPortBlueprintObjectProperty(t *testing.T) {
 
 func TestAccPortBlueprintChangePropertyType(t *testing.T) {
 	type data struct{ Identifier, PropType string }
-	identifier := utils.GenID()
+	identifier := acctest.GenID(t)
 	tmpl, err := template.New("resource").Parse(`
 	resource "port_blueprint" "{{.Identifier}}" {
 		title = "test: {{.Identifier}}"
@@ -438,14 +437,14 @@ func TestAccPortBlueprintChangePropertyType(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps:                    steps,
 	})
 }
 
 func TestAccPortBlueprintChangePropertyTypeProtection(t *testing.T) {
 	type data struct{ Identifier, PropType string }
-	identifier := utils.GenID()
+	identifier := acctest.GenID(t)
 	tmpl, err := template.New("resource").Parse(`
 	resource "port_blueprint" "{{.Identifier}}" {
 		title = "test: {{.Identifier}}"
@@ -468,7 +467,7 @@ func TestAccPortBlueprintChangePropertyTypeProtection(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: acctest.ProviderConfig + step1Text.String(),
@@ -487,8 +486,8 @@ func TestAccPortBlueprintChangePropertyTypeProtection(t *testing.T) {
 }
 
 func TestAccPortBlueprintWithChangelogDestination(t *testing.T) {
-	identifier := utils.GenID()
-	identifier2 := utils.GenID()
+	identifier := acctest.GenID(t)
+	identifier2 := acctest.GenID(t)
 	var testAccActionConfigCreate = fmt.Sprintf(`
 	resource "port_blueprint" "microservice" {
 		title = "TF Provider Test"
@@ -510,7 +509,7 @@ func TestAccPortBlueprintWithChangelogDestination(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: acctest.ProviderConfig + testAccActionConfigCreate,
@@ -530,8 +529,8 @@ func TestAccPortBlueprintWithChangelogDestination(t *testing.T) {
 }
 
 func TestAccPortBlueprintWithRelation(t *testing.T) {
-	identifier1 := utils.GenID()
-	identifier2 := utils.GenID()
+	identifier1 := acctest.GenID(t)
+	identifier2 := acctest.GenID(t)
 	var testAccActionConfigCreate = fmt.Sprintf(`
 	resource "port_blueprint" "microservice1" {
 		title = "TF Provider Test BP2"
@@ -568,7 +567,7 @@ func TestAccPortBlueprintWithRelation(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: acctest.ProviderConfig + testAccActionConfigCreate,
@@ -590,7 +589,7 @@ func TestAccPortBlueprintWithRelation(t *testing.T) {
 }
 
 func TestAccPortBlueprintImport(t *testing.T) {
-	identifier := utils.GenID()
+	identifier := acctest.GenID(t)
 	var testAccActionConfigCreate = fmt.Sprintf(`
 	resource "port_blueprint" "microservice2" {
 		title = "TF Provider Test BP3"
@@ -607,7 +606,7 @@ func TestAccPortBlueprintImport(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: acctest.ProviderConfig + testAccActionConfigCreate,
@@ -627,7 +626,7 @@ func TestAccPortBlueprintImport(t *testing.T) {
 }
 
 func TestAccPortBlueprintWithSpecification(t *testing.T) {
-	identifier := utils.GenID()
+	identifier := acctest.GenID(t)
 	var testAccActionConfigCreate = fmt.Sprintf(`
 	resource "port_blueprint" "microservice" {
 		title = "TF Provider Test BP0"
@@ -652,7 +651,7 @@ func TestAccPortBlueprintWithSpecification(t *testing.T) {
 `, identifier)
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: acctest.ProviderConfig + testAccActionConfigCreate,
@@ -668,8 +667,8 @@ func TestAccPortBlueprintWithSpecification(t *testing.T) {
 }
 
 func TestAccPortBlueprintUpdateRelation(t *testing.T) {
-	envID := utils.GenID()
-	vmID := utils.GenID()
+	envID := acctest.GenID(t)
+	vmID := acctest.GenID(t)
 	var testAccActionConfigCreate = fmt.Sprintf(`
 	resource "port_blueprint" "Environment" {
 		title = "Environment"
@@ -736,7 +735,7 @@ func TestAccPortBlueprintUpdateRelation(t *testing.T) {
 `, envID, vmID)
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: acctest.ProviderConfig + testAccActionConfigCreate,
@@ -757,8 +756,8 @@ func TestAccPortBlueprintUpdateRelation(t *testing.T) {
 }
 
 func TestAccPortBlueprintWithMirrorProperty(t *testing.T) {
-	identifier1 := utils.GenID()
-	identifier2 := utils.GenID()
+	identifier1 := acctest.GenID(t)
+	identifier2 := acctest.GenID(t)
 	var testAccActionConfigCreate = fmt.Sprintf(`
 	resource "port_blueprint" "microservice1" {
 		title = "TF Provider Test BP2"
@@ -800,7 +799,7 @@ func TestAccPortBlueprintWithMirrorProperty(t *testing.T) {
 `, identifier1, identifier2)
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: acctest.ProviderConfig + testAccActionConfigCreate,
@@ -817,7 +816,7 @@ func TestAccPortBlueprintWithMirrorProperty(t *testing.T) {
 }
 
 func TestAccPortBlueprintWithCalculationProperty(t *testing.T) {
-	identifier1 := utils.GenID()
+	identifier1 := acctest.GenID(t)
 	var testAccActionConfigCreate = fmt.Sprintf(`
 	resource "port_blueprint" "microservice1" {
 		title = "TF Provider Test BP2"
@@ -848,7 +847,7 @@ func TestAccPortBlueprintWithCalculationProperty(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: acctest.ProviderConfig + testAccActionConfigCreate,
@@ -864,8 +863,8 @@ func TestAccPortBlueprintWithCalculationProperty(t *testing.T) {
 }
 
 func TestAccPortUpdateBlueprintIdentifier(t *testing.T) {
-	identifier := utils.GenID()
-	updatedIdentifier := utils.GenID()
+	identifier := acctest.GenID(t)
+	updatedIdentifier := acctest.GenID(t)
 	var testAccActionConfigCreate = fmt.Sprintf(`
 	resource "port_blueprint" "microservice" {
 		title = "TF Provider Test"
@@ -886,7 +885,7 @@ func TestAccPortUpdateBlueprintIdentifier(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: acctest.ProviderConfig + testAccActionConfigCreate,
@@ -909,7 +908,7 @@ func TestAccPortUpdateBlueprintIdentifier(t *testing.T) {
 }
 
 func TestAccPortDestroyDeleteAllEntities(t *testing.T) {
-	identifier := utils.GenID()
+	identifier := acctest.GenID(t)
 	title := "Blueprint with entities1"
 	icon := "Terraform"
 	var testAccBlueprintConfigImport = fmt.Sprintf(`
@@ -970,7 +969,7 @@ func TestAccPortDestroyDeleteAllEntities(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config:             acctest.ProviderConfig + testAccBlueprintConfigImport,
@@ -997,7 +996,7 @@ func TestAccPortDestroyDeleteAllEntities(t *testing.T) {
 }
 
 func TestAccPortBlueprintOwnership(t *testing.T) {
-	parentIdentifier := utils.GenID()
+	parentIdentifier := acctest.GenID(t)
 	var testAccConfigDirect = fmt.Sprintf(`
 	resource "port_blueprint" "parent_blueprint" {
 		title = "Parent Blueprint"
@@ -1019,7 +1018,7 @@ func TestAccPortBlueprintOwnership(t *testing.T) {
 	}
 `, parentIdentifier)
 
-	childIdentifier := utils.GenID()
+	childIdentifier := acctest.GenID(t)
 	var testAccConfigInherited = fmt.Sprintf(`
 	resource "port_blueprint" "parent_blueprint" {
 		title = "Parent Blueprint"
@@ -1070,7 +1069,7 @@ func TestAccPortBlueprintOwnership(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: acctest.ProviderConfig + testAccConfigDirect,
@@ -1117,7 +1116,7 @@ func TestAccPortBlueprintCatalogPageCreation(t *testing.T) {
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			// add `s` to handle the plural default page that is being created by port
-			identifier := fmt.Sprintf("test-%ss", utils.GenID()[:10])
+			identifier := fmt.Sprintf("test-%ss", acctest.GenID(t)[:10])
 			title := "Microservices"
 			icon := "Terraform"
 			testAccBlueprintConfig := fmt.Sprintf(`
@@ -1167,7 +1166,7 @@ func TestAccPortBlueprintCatalogPageCreation(t *testing.T) {
 
 			resource.Test(t, resource.TestCase{
 				PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-				ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+				ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 				Steps: []resource.TestStep{
 					{
 						Config:             acctest.ProviderConfig + testAccBlueprintConfig,
@@ -1193,7 +1192,7 @@ func initializePortTestClient(t *testing.T) (*cli.PortClient, context.Context, e
 	if baseUrl == "" {
 		baseUrl = consts.DefaultBaseUrl
 	}
-	c, err := cli.New(baseUrl, cli.WithHeader("User-Agent", version.ProviderVersion))
+	c, err := cli.New(baseUrl, cli.WithHeader("User-Agent", version.ProviderVersion), cli.WithCassette(t.Name()))
 	if err != nil {
 		t.Fatalf("Failed to create Port-labs client: %s", err.Error())
 	}
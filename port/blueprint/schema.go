@@ -4,6 +4,7 @@ import (
 	"context"
 	"regexp"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
@@ -560,10 +561,17 @@ func BlueprintSchema() map[string]schema.Attribute {
 	}
 }
 
+// timeoutsOpts are the operations the `timeouts` block of a blueprint sets the timeout of. Deleting a blueprint with
+// force_delete_entities waits for the migration that deletes its entities.
+var timeoutsOpts = timeouts.Opts{Create: true, Update: true, Delete: true}
+
 func (r *BlueprintResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: blueprintMarkdownDescription,
 		Attributes:          BlueprintSchema(),
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeoutsOpts),
+		},
 	}
 }
 
@@ -0,0 +1,141 @@
+package entity
+
+import (
+	"context"
+	"maps"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
+)
+
+var _ datasource.DataSource = &EntityDataSource{}
+
+func NewEntityDataSource() datasource.DataSource {
+	return &EntityDataSource{}
+}
+
+type EntityDataSource struct {
+	portClient *cli.PortClient
+}
+
+func (d *EntityDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.portClient = req.ProviderData.(*cli.PortClient)
+}
+
+func (d *EntityDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_entity"
+}
+
+func (d *EntityDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data EntityDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	includeCalculatedProperties := data.IncludeCalculatedProperties.ValueBool()
+	e, _, err := d.portClient.ReadEntity(ctx, data.Identifier.ValueString(), data.Blueprint.ValueString(), !includeCalculatedProperties)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to read entity", err.Error())
+		return
+	}
+
+	b, _, err := d.portClient.ReadBlueprint(ctx, data.Blueprint.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("failed to read blueprint", err.Error())
+		return
+	}
+
+	if includeCalculatedProperties {
+		b = blueprintWithCalculatedPropertiesSchema(b, e)
+	}
+
+	state := &EntityModel{}
+	r := &EntityResource{portClient: d.portClient}
+	err = r.refreshEntityState(ctx, state, e, b)
+	if err != nil {
+		resp.Diagnostics.AddError("failed writing entity fields to data source", err.Error())
+		return
+	}
+
+	data.ID = state.ID
+	data.Identifier = state.Identifier
+	data.Blueprint = state.Blueprint
+	data.Title = state.Title
+	data.Icon = state.Icon
+	data.CreatedAt = state.CreatedAt
+	data.CreatedBy = state.CreatedBy
+	data.UpdatedAt = state.UpdatedAt
+	data.UpdatedBy = state.UpdatedBy
+	data.Teams = state.Teams
+	data.Properties = state.Properties
+	data.Relations = state.Relations
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// blueprintWithCalculatedPropertiesSchema returns a copy of the blueprint whose schema also describes the calculation,
+// mirror and aggregation properties returned for the entity. Their types aren't part of the blueprint schema, so the
+// type of calculation properties is taken from the blueprint and the rest are inferred from the entity values.
+func blueprintWithCalculatedPropertiesSchema(b *cli.Blueprint, e *cli.Entity) *cli.Blueprint {
+	extended := *b
+	extended.Schema.Properties = maps.Clone(b.Schema.Properties)
+	if extended.Schema.Properties == nil {
+		extended.Schema.Properties = make(map[string]cli.BlueprintProperty)
+	}
+
+	for k, v := range e.Properties {
+		if _, ok := extended.Schema.Properties[k]; ok {
+			continue
+		}
+		property := cli.BlueprintProperty{Type: inferPropertyType(v)}
+		if calculationProperty, ok := b.CalculationProperties[k]; ok && calculationProperty.Type != "" {
+			property.Type = calculationProperty.Type
+		}
+		if items, ok := v.([]any); ok {
+			property.Type = "array"
+			property.Items = map[string]any{"type": inferItemsType(items)}
+		}
+		extended.Schema.Properties[k] = property
+	}
+
+	return &extended
+}
+
+func inferPropertyType(v any) string {
+	switch v.(type) {
+	case string:
+		return "string"
+	case float64:
+		return "number"
+	case bool:
+		return "boolean"
+	case []any:
+		return "array"
+	default:
+		return "object"
+	}
+}
+
+// inferItemsType returns the common type of the array items. Empty arrays are arrays of string, like arrays without
+// items type, and arrays of mixed types fall back to object, which can hold any JSON value.
+func inferItemsType(items []any) string {
+	if len(items) == 0 {
+		return "string"
+	}
+	itemsType := inferPropertyType(items[0])
+	for _, item := range items[1:] {
+		if inferPropertyType(item) != itemsType {
+			return "object"
+		}
+	}
+	if itemsType == "array" {
+		return "object"
+	}
+	return itemsType
+}
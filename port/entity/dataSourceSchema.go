@@ -0,0 +1,166 @@
+package entity
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func EntityDataSourceSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed: true,
+		},
+		"identifier": schema.StringAttribute{
+			MarkdownDescription: "The identifier of the entity",
+			Required:            true,
+		},
+		"blueprint": schema.StringAttribute{
+			MarkdownDescription: "The blueprint identifier the entity relates to",
+			Required:            true,
+		},
+		"include_calculated_properties": schema.BoolAttribute{
+			MarkdownDescription: "Whether to include the values of calculation, mirror and aggregation properties in `properties`. Defaults to `false`",
+			Optional:            true,
+		},
+		"title": schema.StringAttribute{
+			MarkdownDescription: "The title of the entity",
+			Computed:            true,
+		},
+		"icon": schema.StringAttribute{
+			MarkdownDescription: "The icon of the entity",
+			Computed:            true,
+		},
+		"teams": schema.SetAttribute{
+			MarkdownDescription: "The teams the entity belongs to",
+			Computed:            true,
+			ElementType:         types.StringType,
+		},
+		"properties": schema.SingleNestedAttribute{
+			MarkdownDescription: "The properties of the entity",
+			Computed:            true,
+			Attributes: map[string]schema.Attribute{
+				"string_props": schema.MapAttribute{
+					MarkdownDescription: "The string properties of the entity",
+					Computed:            true,
+					ElementType:         types.StringType,
+				},
+				"number_props": schema.MapAttribute{
+					MarkdownDescription: "The number properties of the entity",
+					Computed:            true,
+					ElementType:         types.Float64Type,
+				},
+				"boolean_props": schema.MapAttribute{
+					MarkdownDescription: "The bool properties of the entity",
+					Computed:            true,
+					ElementType:         types.BoolType,
+				},
+				"object_props": schema.MapAttribute{
+					MarkdownDescription: "The object properties of the entity",
+					Computed:            true,
+					ElementType:         types.StringType,
+				},
+				"array_props": schema.SingleNestedAttribute{
+					MarkdownDescription: "The array properties of the entity",
+					Computed:            true,
+					Attributes: map[string]schema.Attribute{
+						"string_items": schema.MapAttribute{
+							ElementType: types.ListType{ElemType: types.StringType},
+							Computed:    true,
+						},
+						"number_items": schema.MapAttribute{
+							ElementType: types.ListType{ElemType: types.Float64Type},
+							Computed:    true,
+						},
+						"boolean_items": schema.MapAttribute{
+							ElementType: types.ListType{ElemType: types.BoolType},
+							Computed:    true,
+						},
+						"object_items": schema.MapAttribute{
+							ElementType: types.ListType{ElemType: types.StringType},
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+		"relations": schema.SingleNestedAttribute{
+			MarkdownDescription: "The relations of the entity",
+			Computed:            true,
+			Attributes: map[string]schema.Attribute{
+				"single_relations": schema.MapAttribute{
+					MarkdownDescription: "The single relation of the entity",
+					Computed:            true,
+					ElementType:         types.StringType,
+				},
+				"many_relations": schema.MapAttribute{
+					MarkdownDescription: "The many relation of the entity",
+					Computed:            true,
+					ElementType:         types.ListType{ElemType: types.StringType},
+				},
+			},
+		},
+		"created_at": schema.StringAttribute{
+			MarkdownDescription: "The creation date of the entity",
+			Computed:            true,
+		},
+		"created_by": schema.StringAttribute{
+			MarkdownDescription: "The creator of the entity",
+			Computed:            true,
+		},
+		"updated_at": schema.StringAttribute{
+			MarkdownDescription: "The last update date of the entity",
+			Computed:            true,
+		},
+		"updated_by": schema.StringAttribute{
+			MarkdownDescription: "The last updater of the entity",
+			Computed:            true,
+		},
+	}
+}
+
+func (d *EntityDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: EntityDataSourceMarkdownDescription,
+		Attributes:          EntityDataSourceSchema(),
+	}
+}
+
+var EntityDataSourceMarkdownDescription = `
+
+# Entity Data Source
+
+The entity data source allows you to read a single existing entity in Port by its blueprint and identifier, without
+managing it. The ` + "`properties`" + ` and ` + "`relations`" + ` attributes have the same shape as the ` + "`port_entity`" + ` resource.
+
+By default, only the values of the blueprint schema properties are returned. Set ` + "`include_calculated_properties`" + `
+to ` + "`true`" + ` to also read the values of calculation, mirror and aggregation properties.
+
+## Example Usage
+
+` + "```hcl" + `
+
+data "port_entity" "production" {
+  blueprint                     = "environment"
+  identifier                    = "production"
+  include_calculated_properties = true
+}
+
+resource "port_entity" "payments" {
+  title     = "Payments"
+  blueprint = "microservice"
+  properties = {
+    string_props = {
+      "aws-region" = data.port_entity.production.properties.string_props["aws-region"]
+    }
+  }
+  relations = {
+    single_relations = {
+      "environment" = data.port_entity.production.identifier
+    }
+  }
+}
+
+` + "```" + ``
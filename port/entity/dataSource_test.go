@@ -0,0 +1,67 @@
+package entity
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRefreshEntityStateWithCalculatedProperties(t *testing.T) {
+	blueprint := &cli.Blueprint{
+		Identifier: "service",
+		Schema: cli.BlueprintSchema{
+			Properties: map[string]cli.BlueprintProperty{
+				"language": {Type: "string"},
+			},
+		},
+		CalculationProperties: map[string]cli.BlueprintCalculationProperty{
+			"url":     {Type: "string"},
+			"summary": {Type: "object"},
+		},
+	}
+	apiEntity := &cli.Entity{
+		Meta: cli.Meta{
+			CreatedAt: ptrTime(time.Now()),
+			UpdatedAt: ptrTime(time.Now()),
+		},
+		Identifier: "payments",
+		Blueprint:  "service",
+		Properties: map[string]any{
+			"language":      "go",
+			"url":           "https://example.com/payments",
+			"summary":       nil,
+			"region":        "eu-west-1",
+			"openIncidents": float64(3),
+			"regions":       []any{"eu-west-1", "us-east-1"},
+			"scores":        []any{float64(1), float64(2)},
+			"mixed":         []any{"a", float64(1), nil},
+			"tags":          []any{},
+		},
+	}
+
+	extended := blueprintWithCalculatedPropertiesSchema(blueprint, apiEntity)
+	assert.Len(t, blueprint.Schema.Properties, 1, "the original blueprint must not be modified")
+	assert.Equal(t, "object", extended.Schema.Properties["summary"].Type)
+	assert.Equal(t, "number", extended.Schema.Properties["openIncidents"].Type)
+	assert.Equal(t, "number", extended.Schema.Properties["scores"].Items["type"])
+	assert.Equal(t, "object", extended.Schema.Properties["mixed"].Items["type"])
+	assert.Equal(t, "string", extended.Schema.Properties["tags"].Items["type"])
+
+	state := &EntityModel{}
+	r := &EntityResource{portClient: &cli.PortClient{}}
+	err := r.refreshEntityState(context.Background(), state, apiEntity, extended)
+	require.NoError(t, err)
+
+	assert.Equal(t, "go", state.Properties.StringProps["language"].ValueString())
+	assert.Equal(t, "https://example.com/payments", state.Properties.StringProps["url"].ValueString())
+	assert.Equal(t, "eu-west-1", state.Properties.StringProps["region"].ValueString())
+	assert.True(t, state.Properties.ObjectProps["summary"].IsNull())
+	assert.Equal(t, float64(3), state.Properties.NumberProps["openIncidents"].ValueFloat64())
+	assert.Len(t, state.Properties.ArrayProps.StringItems.Elements(), 2)
+	assert.Len(t, state.Properties.ArrayProps.NumberItems.Elements(), 1)
+	assert.Len(t, state.Properties.ArrayProps.ObjectItems.Elements(), 1)
+}
@@ -0,0 +1,121 @@
+package entity_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/acctest"
+)
+
+func TestAccPortEntityDataSource(t *testing.T) {
+	environmentIdentifier := acctest.GenID(t)
+	identifier := acctest.GenID(t)
+	var testAccEntityConfig = fmt.Sprintf(`
+	resource "port_blueprint" "environment" {
+		title = "TF Provider Test Environment"
+		icon = "Terraform"
+		identifier = "%s"
+		properties = {
+			string_props = {
+				region = {
+					title = "Region"
+				}
+			}
+		}
+	}
+	resource "port_blueprint" "microservice" {
+		title = "TF Provider Test BP0"
+		icon = "Terraform"
+		identifier = "%s"
+		properties = {
+			string_props = {
+				myStringIdentifier = {
+					title = "My String Identifier"
+				}
+			}
+			number_props = {
+				myNumberIdentifier = {
+					title = "My Number Identifier"
+				}
+			}
+		}
+		relations = {
+			environment = {
+				target = port_blueprint.environment.identifier
+			}
+		}
+		mirror_properties = {
+			region = {
+				path = "environment.region"
+			}
+		}
+		calculation_properties = {
+			upperTitle = {
+				calculation = ".title | ascii_upcase"
+				type = "string"
+			}
+		}
+	}
+	resource "port_entity" "environment" {
+		title = "Production"
+		blueprint = port_blueprint.environment.identifier
+		properties = {
+			string_props = {
+				region = "eu-west-1"
+			}
+		}
+	}
+	resource "port_entity" "microservice" {
+		title = "Payments"
+		blueprint = port_blueprint.microservice.identifier
+		properties = {
+			string_props = {
+				myStringIdentifier = "My String Value"
+			}
+			number_props = {
+				myNumberIdentifier = 123
+			}
+		}
+		relations = {
+			single_relations = {
+				environment = port_entity.environment.identifier
+			}
+		}
+	}
+`, environmentIdentifier, identifier)
+
+	var testAccEntityDataSourceConfig = `
+	data "port_entity" "microservice" {
+		blueprint = port_entity.microservice.blueprint
+		identifier = port_entity.microservice.identifier
+	}
+	data "port_entity" "microservice_with_calculated" {
+		blueprint = port_entity.microservice.blueprint
+		identifier = port_entity.microservice.identifier
+		include_calculated_properties = true
+	}
+`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig + testAccEntityConfig + testAccEntityDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.port_entity.microservice", "title", "Payments"),
+					resource.TestCheckResourceAttr("data.port_entity.microservice", "blueprint", identifier),
+					resource.TestCheckResourceAttr("data.port_entity.microservice", "properties.string_props.myStringIdentifier", "My String Value"),
+					resource.TestCheckResourceAttr("data.port_entity.microservice", "properties.number_props.myNumberIdentifier", "123"),
+					resource.TestCheckResourceAttrPair("data.port_entity.microservice", "relations.single_relations.environment", "port_entity.environment", "identifier"),
+					resource.TestCheckNoResourceAttr("data.port_entity.microservice", "properties.string_props.upperTitle"),
+					resource.TestCheckNoResourceAttr("data.port_entity.microservice", "properties.string_props.region"),
+					resource.TestCheckResourceAttr("data.port_entity.microservice_with_calculated", "properties.string_props.myStringIdentifier", "My String Value"),
+					resource.TestCheckResourceAttr("data.port_entity.microservice_with_calculated", "properties.string_props.upperTitle", "PAYMENTS"),
+					resource.TestCheckResourceAttr("data.port_entity.microservice_with_calculated", "properties.string_props.region", "eu-west-1"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,312 @@
+package entity
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/utils"
+)
+
+// entitiesBatchSize is the amount of entities the bulk entities API accepts in a single request.
+const entitiesBatchSize = 20
+
+// entitiesDeleteConcurrency is the amount of entities deleted at the same time, since the API deletes entities one by
+// one. The rate limiter of the client may allow fewer requests in flight.
+const entitiesDeleteConcurrency = 10
+
+var _ resource.Resource = &EntitiesResource{}
+
+func NewEntitiesResource() resource.Resource {
+	return &EntitiesResource{}
+}
+
+// EntitiesResource manages many entities of a blueprint as a unit. Only the entities that changed are sent to the
+// bulk entities API, and the entities it fails to upsert are reported at their key of the `entities` map.
+type EntitiesResource struct {
+	portClient *cli.PortClient
+}
+
+func (r *EntitiesResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_entities"
+}
+
+func (r *EntitiesResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.portClient = req.ProviderData.(*cli.PortClient)
+}
+
+func (r *EntitiesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state *EntitiesModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	b, statusCode, err := r.portClient.ReadBlueprint(ctx, state.Blueprint.ValueString())
+	if err != nil {
+		if statusCode == 404 {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("failed to read blueprint", err.Error())
+		return
+	}
+
+	if len(state.Entities) != 0 {
+		searchResult, err := r.portClient.Search(ctx, &cli.SearchRequestQuery{
+			Query: &map[string]any{
+				"combinator": "and",
+				"rules": []any{
+					map[string]any{"property": "$blueprint", "operator": "=", "value": b.Identifier},
+					map[string]any{"property": "$identifier", "operator": "in", "value": slices.Sorted(maps.Keys(state.Entities))},
+				},
+			},
+			ExcludeCalculatedProperties: utils.PtrTo(true),
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("failed to search entities", err.Error())
+			return
+		}
+
+		// Entities that were deleted outside of Terraform are dropped from the state, so they are planned again.
+		entities := make(map[string]*EntitiesEntityModel, len(searchResult.Entities))
+		entityResource := &EntityResource{portClient: r.portClient}
+		for _, e := range searchResult.Entities {
+			entityState := &EntityModel{}
+			if err := entityResource.refreshEntityState(ctx, entityState, &e, b); err != nil {
+				resp.Diagnostics.AddAttributeError(path.Root("entities").AtMapKey(e.Identifier), "failed writing entity fields to resource", err.Error())
+				return
+			}
+			entities[e.Identifier] = entitiesEntityFromModel(entityState)
+		}
+		state.Entities = entities
+	}
+
+	state.ID = types.StringValue(b.Identifier)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *EntitiesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var state *EntitiesModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bp, _, err := r.portClient.ReadBlueprint(ctx, state.Blueprint.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("failed to read blueprint", err.Error())
+		return
+	}
+
+	planned := state.Entities
+	upserted := r.upsertEntities(ctx, bp, planned, slices.Sorted(maps.Keys(planned)), state.CreateMissingRelatedEntities.ValueBool(), &resp.Diagnostics)
+	if len(upserted) == 0 {
+		// Nothing was created, so the resource isn't either.
+		return
+	}
+
+	// When some of the entities failed, Terraform taints the resource, and the next apply replaces it with all of its
+	// entities. Only the entities that were created are kept in the state, so replacing it deletes just them.
+	state.Entities = make(map[string]*EntitiesEntityModel, len(upserted))
+	for _, identifier := range upserted {
+		state.Entities[identifier] = planned[identifier]
+	}
+	state.ID = types.StringValue(bp.Identifier)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *EntitiesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var state *EntitiesModel
+	var previousState *EntitiesModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &previousState)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bp, _, err := r.portClient.ReadBlueprint(ctx, state.Blueprint.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("failed to read blueprint", err.Error())
+		return
+	}
+
+	changed, removed := diffEntities(ctx, bp, previousState.Entities, state.Entities)
+
+	planned := state.Entities
+	entities := maps.Clone(previousState.Entities)
+	if entities == nil {
+		entities = map[string]*EntitiesEntityModel{}
+	}
+	for _, identifier := range r.upsertEntities(ctx, bp, planned, changed, state.CreateMissingRelatedEntities.ValueBool(), &resp.Diagnostics) {
+		entities[identifier] = planned[identifier]
+	}
+	for _, identifier := range r.deleteEntities(ctx, bp.Identifier, removed, &resp.Diagnostics) {
+		delete(entities, identifier)
+	}
+
+	// Entities that failed keep their previous state, so only they are planned again.
+	state.Entities = entities
+	state.ID = types.StringValue(bp.Identifier)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *EntitiesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state *EntitiesModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	identifiers := slices.Sorted(maps.Keys(state.Entities))
+	deleted := r.deleteEntities(ctx, state.Blueprint.ValueString(), identifiers, &resp.Diagnostics)
+	if len(deleted) == len(identifiers) {
+		return
+	}
+
+	// Keep the entities that failed to be deleted in the state, so the next destroy retries them.
+	for _, identifier := range deleted {
+		delete(state.Entities, identifier)
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// diffEntities returns the identifiers of the planned entities that are new or differ from their state, and of the
+// entities in the state that are no longer planned. Entities are compared by the body they are sent to the API with,
+// and entities that can't be converted to one are treated as changed, so upsertEntities reports them.
+func diffEntities(ctx context.Context, bp *cli.Blueprint, previous, planned map[string]*EntitiesEntityModel) ([]string, []string) {
+	var changed, removed []string
+	for _, identifier := range slices.Sorted(maps.Keys(planned)) {
+		previousEntity, ok := previous[identifier]
+		if !ok {
+			changed = append(changed, identifier)
+			continue
+		}
+		previousBody, previousErr := entityResourceToBody(ctx, previousEntity.entityModel(bp.Identifier, identifier), bp)
+		plannedBody, plannedErr := entityResourceToBody(ctx, planned[identifier].entityModel(bp.Identifier, identifier), bp)
+		if previousErr != nil || plannedErr != nil || !reflect.DeepEqual(previousBody, plannedBody) {
+			changed = append(changed, identifier)
+		}
+	}
+	for _, identifier := range slices.Sorted(maps.Keys(previous)) {
+		if _, ok := planned[identifier]; !ok {
+			removed = append(removed, identifier)
+		}
+	}
+	return changed, removed
+}
+
+// upsertEntities upserts the given entities in batches, and returns the identifiers of the entities that were
+// upserted. Every entity that failed is reported at its key of the `entities` map.
+func (r *EntitiesResource) upsertEntities(ctx context.Context, bp *cli.Blueprint, entities map[string]*EntitiesEntityModel, identifiers []string, createMissingRelatedEntities bool, diags *diag.Diagnostics) []string {
+	var upserted []string
+	bodies := make([]cli.Entity, 0, len(identifiers))
+	converted := true
+	for _, identifier := range identifiers {
+		e, err := entityResourceToBody(ctx, entities[identifier].entityModel(bp.Identifier, identifier), bp)
+		if err != nil {
+			diags.AddAttributeError(path.Root("entities").AtMapKey(identifier), "failed to convert entity resource to body", err.Error())
+			converted = false
+			continue
+		}
+		bodies = append(bodies, *e)
+	}
+	// Invalid entities fail before any request, so they don't leave the others half applied.
+	if !converted {
+		return nil
+	}
+
+	for start := 0; start < len(bodies); start += entitiesBatchSize {
+		end := min(start+entitiesBatchSize, len(bodies))
+		batch := identifiers[start:end]
+
+		result, err := r.portClient.UpsertEntities(ctx, bp.Identifier, bodies[start:end], createMissingRelatedEntities)
+		if err != nil {
+			// A failed request fails its whole batch, and most likely the next ones too, so stop here.
+			diags.AddError("failed to upsert entities", fmt.Sprintf("failed to upsert the entities %s: %s", strings.Join(batch, ", "), err.Error()))
+			return upserted
+		}
+
+		for _, e := range result.Entities {
+			if e.Index >= 0 && e.Index < len(batch) {
+				upserted = append(upserted, batch[e.Index])
+			}
+		}
+		for _, e := range result.Errors {
+			identifier := e.Identifier
+			if e.Index >= 0 && e.Index < len(batch) {
+				identifier = batch[e.Index]
+			}
+			diags.AddAttributeError(path.Root("entities").AtMapKey(identifier), "failed to upsert entity", fmt.Sprintf("%s: %s", e.Error, e.Message))
+		}
+	}
+	return upserted
+}
+
+// deleteEntities deletes the given entities of the blueprint concurrently, and returns the identifiers of the entities
+// that were deleted in the given order. Every entity that failed is reported at its key of the `entities` map.
+func (r *EntitiesResource) deleteEntities(ctx context.Context, blueprint string, identifiers []string, diags *diag.Diagnostics) []string {
+	errs := make([]error, len(identifiers))
+	slots := make(chan struct{}, entitiesDeleteConcurrency)
+	var wg sync.WaitGroup
+	for i, identifier := range identifiers {
+		slots <- struct{}{}
+		wg.Go(func() {
+			defer func() { <-slots }()
+			errs[i] = r.portClient.DeleteEntity(ctx, identifier, blueprint)
+		})
+	}
+	wg.Wait()
+
+	var deleted []string
+	for i, identifier := range identifiers {
+		if err := errs[i]; err != nil {
+			diags.AddAttributeError(path.Root("entities").AtMapKey(identifier), "failed to delete entity", err.Error())
+			continue
+		}
+		deleted = append(deleted, identifier)
+	}
+	return deleted
+}
+
+func (m *EntitiesEntityModel) entityModel(blueprint string, identifier string) *EntityModel {
+	return &EntityModel{
+		Identifier: types.StringValue(identifier),
+		Blueprint:  types.StringValue(blueprint),
+		Title:      m.Title,
+		Icon:       m.Icon,
+		Teams:      m.Teams,
+		Properties: m.Properties,
+		Relations:  m.Relations,
+	}
+}
+
+func entitiesEntityFromModel(state *EntityModel) *EntitiesEntityModel {
+	return &EntitiesEntityModel{
+		Title:      state.Title,
+		Icon:       state.Icon,
+		Teams:      state.Teams,
+		Properties: state.Properties,
+		Relations:  state.Relations,
+	}
+}
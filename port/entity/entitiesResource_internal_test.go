@@ -0,0 +1,206 @@
+package entity
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"net/http"
+	"slices"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/acctest/fakeclient"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEntitiesUpsertAndDelete(t *testing.T) {
+	ctx := context.Background()
+	server, client := fakeclient.New(t)
+
+	bp, err := client.CreateBlueprint(ctx, &cli.Blueprint{
+		Identifier: "microservice",
+		Title:      "Microservice",
+		Schema: cli.BlueprintSchema{Properties: map[string]cli.BlueprintProperty{
+			"language": {Type: "string", Title: utils.PtrTo("Language")},
+		}},
+	}, nil)
+	require.NoError(t, err)
+
+	entities := map[string]*EntitiesEntityModel{}
+	var identifiers []string
+	for i := range 45 {
+		identifier := fmt.Sprintf("service-%02d", i)
+		identifiers = append(identifiers, identifier)
+		entities[identifier] = &EntitiesEntityModel{
+			Title: types.StringValue(identifier),
+			Properties: &EntityPropertiesModel{
+				StringProps: map[string]types.String{"language": types.StringValue("Go")},
+			},
+		}
+	}
+	// An undefined property fails only its own entity, not its batch.
+	entities["service-30"].Properties.StringProps = map[string]types.String{"framework": types.StringValue("gin")}
+
+	r := &EntitiesResource{portClient: client}
+	var diags diag.Diagnostics
+	upserted := r.upsertEntities(ctx, bp, entities, identifiers, false, &diags)
+	assert.Len(t, upserted, 44)
+	assert.NotContains(t, upserted, "service-30")
+	require.Len(t, diags, 1)
+	assert.Equal(t, "failed to upsert entity", diags[0].Summary())
+	assert.Contains(t, diags[0].Detail(), `Property "framework" is not defined`)
+	withPath, ok := diags[0].(diag.DiagnosticWithPath)
+	require.True(t, ok)
+	assert.Equal(t, path.Root("entities").AtMapKey("service-30"), withPath.Path())
+
+	stored, ok := server.Object("entity", "microservice/service-44")
+	require.True(t, ok, "entities of the last batch are upserted")
+	assert.Equal(t, map[string]any{"language": "Go"}, stored["properties"])
+
+	previous := map[string]*EntitiesEntityModel{
+		"service-00": entities["service-00"],
+		"service-01": entities["service-01"],
+		"service-02": entities["service-02"],
+	}
+	planned := map[string]*EntitiesEntityModel{
+		"service-00": entities["service-00"],
+		"service-01": {Title: types.StringValue("renamed")},
+		"service-03": entities["service-03"],
+	}
+	changed, removed := diffEntities(ctx, bp, previous, planned)
+	assert.Equal(t, []string{"service-01", "service-03"}, changed)
+	assert.Equal(t, []string{"service-02"}, removed)
+
+	diags = nil
+	deleted := r.deleteEntities(ctx, "microservice", []string{"service-02", "service-30"}, &diags)
+	assert.Equal(t, []string{"service-02"}, deleted)
+	require.Len(t, diags, 1)
+	assert.Equal(t, path.Root("entities").AtMapKey("service-30"), diags[0].(diag.DiagnosticWithPath).Path())
+	_, ok = server.Object("entity", "microservice/service-02")
+	assert.False(t, ok)
+}
+
+func TestEntitiesDeleteMany(t *testing.T) {
+	ctx := context.Background()
+	server, client := fakeclient.New(t)
+
+	// Slow down deletes outside the fake's lock and track how many are in flight at the same time.
+	var inFlight, maxInFlight atomic.Int32
+	handler := server.Config.Handler
+	server.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodDelete {
+			n := inFlight.Add(1)
+			defer inFlight.Add(-1)
+			for current := maxInFlight.Load(); n > current && !maxInFlight.CompareAndSwap(current, n); current = maxInFlight.Load() {
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+		handler.ServeHTTP(w, r)
+	})
+
+	_, err := client.CreateBlueprint(ctx, &cli.Blueprint{Identifier: "microservice", Title: "Microservice"}, nil)
+	require.NoError(t, err)
+	var identifiers []string
+	for i := range 200 {
+		identifier := fmt.Sprintf("service-%03d", i)
+		identifiers = append(identifiers, identifier)
+		// Every tenth entity doesn't exist, so deleting it fails.
+		if i%10 == 9 {
+			continue
+		}
+		server.PutObject("entity", "microservice/"+identifier, map[string]any{"identifier": identifier, "blueprint": "microservice"})
+	}
+
+	r := &EntitiesResource{portClient: client}
+	var diags diag.Diagnostics
+	deleted := r.deleteEntities(ctx, "microservice", identifiers, &diags)
+
+	assert.Len(t, deleted, 180)
+	assert.True(t, slices.IsSorted(deleted), "deleted entities keep the given order")
+	require.Len(t, diags, 20)
+	for i, d := range diags {
+		assert.Equal(t, path.Root("entities").AtMapKey(fmt.Sprintf("service-%03d", i*10+9)), d.(diag.DiagnosticWithPath).Path())
+	}
+	for _, identifier := range deleted {
+		_, ok := server.Object("entity", "microservice/"+identifier)
+		assert.False(t, ok, identifier)
+	}
+	assert.Greater(t, maxInFlight.Load(), int32(1), "entities are deleted concurrently")
+	assert.LessOrEqual(t, maxInFlight.Load(), int32(entitiesDeleteConcurrency))
+}
+
+func TestEntitiesCreatePartialFailure(t *testing.T) {
+	ctx := context.Background()
+	server, client := fakeclient.New(t)
+
+	_, err := client.CreateBlueprint(ctx, &cli.Blueprint{
+		Identifier: "microservice",
+		Title:      "Microservice",
+		Schema: cli.BlueprintSchema{Properties: map[string]cli.BlueprintProperty{
+			"language": {Type: "string"},
+			"config":   {Type: "object"},
+		}},
+	}, nil)
+	require.NoError(t, err)
+
+	r := &EntitiesResource{portClient: client}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	require.False(t, schemaResp.Diagnostics.HasError(), schemaResp.Diagnostics)
+	nullState := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+
+	create := func(t *testing.T, entities map[string]*EntitiesEntityModel) *resource.CreateResponse {
+		plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: nullState.Raw}
+		diags := plan.Set(ctx, &EntitiesModel{
+			ID:                           types.StringUnknown(),
+			Blueprint:                    types.StringValue("microservice"),
+			CreateMissingRelatedEntities: types.BoolValue(false),
+			Entities:                     entities,
+		})
+		require.False(t, diags.HasError(), diags)
+		resp := &resource.CreateResponse{State: nullState}
+		r.Create(ctx, resource.CreateRequest{Plan: plan}, resp)
+		return resp
+	}
+	entity := func(properties *EntityPropertiesModel) *EntitiesEntityModel {
+		return &EntitiesEntityModel{Title: types.StringValue("Service"), Properties: properties}
+	}
+
+	t.Run("invalid entity", func(t *testing.T) {
+		resp := create(t, map[string]*EntitiesEntityModel{
+			"api": entity(&EntityPropertiesModel{StringProps: map[string]types.String{"language": types.StringValue("Go")}}),
+			"web": entity(&EntityPropertiesModel{ObjectProps: map[string]types.String{"config": types.StringValue("{")}}),
+		})
+		require.Len(t, resp.Diagnostics, 1)
+		assert.Equal(t, "failed to convert entity resource to body", resp.Diagnostics[0].Summary())
+		assert.True(t, resp.State.Raw.IsNull(), "the resource isn't created")
+		_, ok := server.Object("entity", "microservice/api")
+		assert.False(t, ok, "no entity is created before all of them are valid")
+	})
+
+	t.Run("failed entity", func(t *testing.T) {
+		resp := create(t, map[string]*EntitiesEntityModel{
+			"api": entity(&EntityPropertiesModel{StringProps: map[string]types.String{"language": types.StringValue("Go")}}),
+			"web": entity(&EntityPropertiesModel{StringProps: map[string]types.String{"framework": types.StringValue("gin")}}),
+		})
+		require.Len(t, resp.Diagnostics, 1)
+		assert.Equal(t, "failed to upsert entity", resp.Diagnostics[0].Summary())
+
+		var state EntitiesModel
+		require.False(t, resp.State.Get(ctx, &state).HasError())
+		assert.Equal(t, []string{"api"}, slices.Collect(maps.Keys(state.Entities)),
+			"the state keeps the created entities, so replacing the tainted resource deletes them")
+		_, ok := server.Object("entity", "microservice/api")
+		assert.True(t, ok)
+	})
+}
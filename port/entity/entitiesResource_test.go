@@ -0,0 +1,86 @@
+package entity_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/acctest"
+)
+
+func TestAccPortEntities(t *testing.T) {
+	identifier := acctest.GenID(t)
+	var testAccBlueprintConfig = fmt.Sprintf(`
+	resource "port_blueprint" "microservice" {
+		title = "TF Provider Test BP0"
+		icon = "Terraform"
+		identifier = "%s"
+		properties = {
+			"string_props" = {
+				"language" =  {
+					"title" = "Language"
+				}
+			}
+		}
+	}
+	`, identifier)
+	var testAccEntitiesConfigCreate = testAccBlueprintConfig + `
+	resource "port_entities" "microservices" {
+		blueprint = port_blueprint.microservice.identifier
+		entities = {
+			"api" = {
+				title = "API"
+				properties = {
+					"string_props" = {
+						"language" = "Go"
+					}
+				}
+			}
+			"web" = {
+				title = "Web"
+				icon = "Terraform"
+			}
+		}
+	}
+	`
+	var testAccEntitiesConfigUpdate = testAccBlueprintConfig + `
+	resource "port_entities" "microservices" {
+		blueprint = port_blueprint.microservice.identifier
+		entities = {
+			"api" = {
+				title = "API Service"
+				properties = {
+					"string_props" = {
+						"language" = "Go"
+					}
+				}
+			}
+		}
+	}
+	`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
+
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig + testAccEntitiesConfigCreate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("port_entities.microservices", "id", identifier),
+					resource.TestCheckResourceAttr("port_entities.microservices", "entities.%", "2"),
+					resource.TestCheckResourceAttr("port_entities.microservices", "entities.api.title", "API"),
+					resource.TestCheckResourceAttr("port_entities.microservices", "entities.api.properties.string_props.language", "Go"),
+					resource.TestCheckResourceAttr("port_entities.microservices", "entities.web.icon", "Terraform"),
+				),
+			},
+			{
+				Config: acctest.ProviderConfig + testAccEntitiesConfigUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("port_entities.microservices", "entities.%", "1"),
+					resource.TestCheckResourceAttr("port_entities.microservices", "entities.api.title", "API Service"),
+				),
+			},
+		},
+	})
+}
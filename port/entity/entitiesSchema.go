@@ -0,0 +1,68 @@
+package entity
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func EntitiesSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed: true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"blueprint": schema.StringAttribute{
+			MarkdownDescription: "The blueprint identifier the entities relate to",
+			Required:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"create_missing_related_entities": schema.BoolAttribute{
+			MarkdownDescription: "Whether to create missing related entities",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(false),
+		},
+		"entities": schema.MapNestedAttribute{
+			MarkdownDescription: "The entities, by their identifier",
+			Required:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"title": schema.StringAttribute{
+						MarkdownDescription: "The title of the entity",
+						Required:            true,
+					},
+					"icon": schema.StringAttribute{
+						MarkdownDescription: "The icon of the entity",
+						Optional:            true,
+					},
+					"teams": schema.SetAttribute{
+						MarkdownDescription: "The teams the entity belongs to",
+						Optional:            true,
+						ElementType:         types.StringType,
+					},
+					"properties": entityPropertiesSchema(),
+					"relations":  entityRelationsSchema(),
+				},
+			},
+		},
+	}
+}
+
+func (r *EntitiesResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Entities resource, manages a set of entities of a blueprint with the bulk entities API. " +
+			"Entities of the blueprint that aren't in `entities` are left as is. If some of the entities fail when the " +
+			"resource is created, the resource is tainted, and the next apply replaces it with all of its entities.",
+		Attributes: EntitiesSchema(),
+	}
+}
@@ -149,6 +149,11 @@ func entityResourceToBody(ctx context.Context, state *EntityModel, bp *cli.Bluep
 		}
 	}
 
+	err := writeValuesToBody(ctx, state.Values, bp, properties)
+	if err != nil {
+		return nil, err
+	}
+
 	e.Properties = properties
 
 	relations, err := writeRelationsToBody(ctx, state.Relations)
@@ -0,0 +1,159 @@
+package entity
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/flex"
+)
+
+// writeValuesToBody writes the properties of the `values` attribute to the body. Scalars are coerced to the type of
+// their blueprint property, as Terraform converts the values of typed variables, e.g. the numbers of a map(string).
+func writeValuesToBody(ctx context.Context, values types.Dynamic, bp *cli.Blueprint, properties map[string]interface{}) error {
+	if values.IsNull() || values.IsUnderlyingValueNull() {
+		return nil
+	}
+
+	v, err := flex.FrameworkDynamicToGoValue(ctx, values)
+	if err != nil {
+		return err
+	}
+	object, ok := v.(map[string]any)
+	if !ok {
+		return fmt.Errorf("values must be an object of property identifiers to their values, got %T", v)
+	}
+
+	for identifier, value := range object {
+		properties[identifier] = coercePropertyValue(bp.Schema.Properties[identifier].Type, value)
+	}
+	return nil
+}
+
+// coercePropertyValue converts a scalar to the type of its blueprint property, when Terraform could have converted it
+// from that type. Other values are returned as is, and the API validates them.
+func coercePropertyValue(propertyType string, value any) any {
+	switch propertyType {
+	case "number":
+		if s, ok := value.(string); ok {
+			if n, err := strconv.ParseFloat(s, 64); err == nil {
+				return n
+			}
+		}
+	case "boolean":
+		if s, ok := value.(string); ok {
+			if b, err := strconv.ParseBool(s); err == nil {
+				return b
+			}
+		}
+	case "string":
+		switch v := value.(type) {
+		case int64:
+			return strconv.FormatInt(v, 10)
+		case float64:
+			return strconv.FormatFloat(v, 'f', -1, 64)
+		case bool:
+			return strconv.FormatBool(v)
+		}
+	}
+	return value
+}
+
+// valuesAttributes returns the values of the `values` attribute by property identifier. It's an object, or a map when
+// it's set from a typed variable like a map(string).
+func valuesAttributes(values types.Dynamic) (map[string]attr.Value, bool) {
+	if values.IsNull() || values.IsUnknown() || values.IsUnderlyingValueNull() || values.IsUnderlyingValueUnknown() {
+		return nil, false
+	}
+	switch v := values.UnderlyingValue().(type) {
+	case basetypes.ObjectValue:
+		return v.Attributes(), true
+	case basetypes.MapValue:
+		return v.Elements(), true
+	}
+	return nil, false
+}
+
+// refreshValuesEntityState refreshes the `values` attribute from the properties of the entity. A value that the
+// entity still has keeps its prior Terraform value, so its type and number precision don't change between the
+// configuration and the state. Properties that aren't in the prior value are added only when they are set, as the
+// API returns every property of the blueprint. A map keeps its element type, unless a refreshed value can't be
+// converted to it, which turns the map into an object.
+func refreshValuesEntityState(ctx context.Context, prior types.Dynamic, properties map[string]any, blueprint *cli.Blueprint) (types.Dynamic, error) {
+	priorAttributes, ok := valuesAttributes(prior)
+	priorValues := map[string]any{}
+	if ok {
+		v, err := flex.FrameworkDynamicToGoValue(ctx, prior)
+		if err != nil {
+			return prior, err
+		}
+		priorValues = v.(map[string]any)
+	}
+	var elementType attr.Type
+	if m, ok := prior.UnderlyingValue().(basetypes.MapValue); ok {
+		elementType = m.ElementType(ctx)
+	}
+
+	attributes := map[string]attr.Value{}
+	for identifier, priorValue := range priorValues {
+		value, ok := properties[identifier]
+		if ok && sameJSON(coercePropertyValue(blueprint.Schema.Properties[identifier].Type, priorValue), value) {
+			attributes[identifier] = priorAttributes[identifier]
+		}
+	}
+	for identifier, value := range properties {
+		if _, ok := attributes[identifier]; ok || value == nil {
+			continue
+		}
+		if types.StringType.Equal(elementType) {
+			value = coercePropertyValue("string", value)
+		}
+		refreshed, err := flex.GoValueToFrameworkDynamic(value)
+		if err != nil {
+			return prior, err
+		}
+		attributes[identifier] = refreshed.UnderlyingValue()
+	}
+
+	isMap := elementType != nil
+	for _, value := range attributes {
+		isMap = isMap && elementType.Equal(value.Type(ctx))
+	}
+	if isMap {
+		m, diags := types.MapValue(elementType, attributes)
+		if diags.HasError() {
+			return prior, fmt.Errorf("failed to convert the properties to values: %s", diags.Errors()[0].Detail())
+		}
+		return types.DynamicValue(m), nil
+	}
+
+	attributeTypes := make(map[string]attr.Type, len(attributes))
+	for identifier, value := range attributes {
+		attributeTypes[identifier] = value.Type(ctx)
+	}
+	object, diags := types.ObjectValue(attributeTypes, attributes)
+	if diags.HasError() {
+		return prior, fmt.Errorf("failed to convert the properties to values: %s", diags.Errors()[0].Detail())
+	}
+	return types.DynamicValue(object), nil
+}
+
+// sameJSON reports whether two values encode to the same JSON, e.g. the whole numbers of Terraform that convert to
+// int64 and the numbers of the API that decode to float64.
+func sameJSON(a, b any) bool {
+	aJSON, err := json.Marshal(a)
+	if err != nil {
+		return false
+	}
+	bJSON, err := json.Marshal(b)
+	if err != nil {
+		return false
+	}
+	return bytes.Equal(aJSON, bJSON)
+}
@@ -0,0 +1,150 @@
+package entity
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEntityValuesRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	blueprint := &cli.Blueprint{
+		Identifier: "microservice",
+		Schema: cli.BlueprintSchema{Properties: map[string]cli.BlueprintProperty{
+			"language": {Type: "string"},
+			"replicas": {Type: "number"},
+			"cpu":      {Type: "number"},
+			"tags":     {Type: "array"},
+			"metadata": {Type: "object"},
+			"owner":    {Type: "string"},
+			"public":   {Type: "boolean"},
+		}},
+	}
+
+	// Terraform parses numbers with more precision than float64 has.
+	cpu, _, err := big.ParseFloat("0.1", 10, 512, big.ToNearestEven)
+	require.NoError(t, err)
+	tags, diags := types.TupleValue([]attr.Type{types.StringType}, []attr.Value{types.StringValue("api")})
+	require.False(t, diags.HasError())
+	metadata, diags := types.ObjectValue(map[string]attr.Type{"tier": types.NumberType}, map[string]attr.Value{"tier": types.NumberValue(big.NewFloat(1))})
+	require.False(t, diags.HasError())
+	values, diags := types.ObjectValue(map[string]attr.Type{
+		"language": types.StringType,
+		"replicas": types.StringType,
+		"cpu":      types.NumberType,
+		"tags":     tags.Type(ctx),
+		"metadata": metadata.Type(ctx),
+	}, map[string]attr.Value{
+		"language": types.StringValue("Go"),
+		"replicas": types.StringValue("3"),
+		"cpu":      types.NumberValue(cpu),
+		"tags":     tags,
+		"metadata": metadata,
+	})
+	require.False(t, diags.HasError())
+	state := &EntityModel{
+		Identifier: types.StringValue("api"),
+		Title:      types.StringValue("API"),
+		Values:     types.DynamicValue(values),
+	}
+
+	e, err := entityResourceToBody(ctx, state, blueprint)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{
+		"language": "Go",
+		"replicas": float64(3),
+		"cpu":      0.1,
+		"tags":     []any{"api"},
+		"metadata": map[string]any{"tier": int64(1)},
+	}, e.Properties, "the string of a number property is sent as a number")
+
+	// The API returns every property of the blueprint, with the numbers decoded as float64.
+	e.Properties = map[string]any{
+		"language": "Go",
+		"replicas": float64(3),
+		"cpu":      0.1,
+		"tags":     []any{"api"},
+		"metadata": map[string]any{"tier": float64(1)},
+		"owner":    nil,
+		"public":   true,
+	}
+	e.Meta = cli.Meta{CreatedAt: ptrTime(time.Now()), UpdatedAt: ptrTime(time.Now())}
+	r := &EntityResource{portClient: &cli.PortClient{}}
+	require.NoError(t, r.refreshEntityState(ctx, state, e, blueprint))
+	assert.Nil(t, state.Properties)
+
+	refreshed, ok := state.Values.UnderlyingValue().(types.Object)
+	require.True(t, ok)
+	attributes := refreshed.Attributes()
+	for _, identifier := range []string{"language", "replicas", "cpu", "tags", "metadata"} {
+		assert.True(t, values.Attributes()[identifier].Equal(attributes[identifier]), "%s keeps its prior value", identifier)
+	}
+	assert.NotContains(t, attributes, "owner", "unset properties aren't added")
+	assert.Equal(t, types.BoolValue(true), attributes["public"], "properties set outside of Terraform are added")
+
+	e.Properties["language"] = "Rust"
+	require.NoError(t, r.refreshEntityState(ctx, state, e, blueprint))
+	assert.Equal(t, types.StringValue("Rust"), state.Values.UnderlyingValue().(types.Object).Attributes()["language"])
+
+	state.Values = types.DynamicValue(types.StringValue("Go"))
+	_, err = entityResourceToBody(ctx, state, blueprint)
+	assert.ErrorContains(t, err, "values must be an object")
+}
+
+func TestEntityValuesMapRefresh(t *testing.T) {
+	ctx := context.Background()
+	blueprint := &cli.Blueprint{
+		Identifier: "microservice",
+		Schema: cli.BlueprintSchema{Properties: map[string]cli.BlueprintProperty{
+			"language": {Type: "string"},
+			"replicas": {Type: "number"},
+			"owner":    {Type: "string"},
+			"public":   {Type: "boolean"},
+			"tags":     {Type: "array"},
+		}},
+	}
+
+	// A map(string) variable converts the number of replicas to a string.
+	values, diags := types.MapValue(types.StringType, map[string]attr.Value{
+		"language": types.StringValue("Go"),
+		"replicas": types.StringValue("3"),
+	})
+	require.False(t, diags.HasError())
+	state := &EntityModel{
+		Identifier: types.StringValue("api"),
+		Title:      types.StringValue("API"),
+		Values:     types.DynamicValue(values),
+	}
+
+	e, err := entityResourceToBody(ctx, state, blueprint)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{"language": "Go", "replicas": float64(3)}, e.Properties)
+
+	e.Properties = map[string]any{"language": "Go", "replicas": float64(3), "owner": nil, "public": true}
+	e.Meta = cli.Meta{CreatedAt: ptrTime(time.Now()), UpdatedAt: ptrTime(time.Now())}
+	r := &EntityResource{portClient: &cli.PortClient{}}
+	require.NoError(t, r.refreshEntityState(ctx, state, e, blueprint))
+
+	refreshed, ok := state.Values.UnderlyingValue().(types.Map)
+	require.True(t, ok, "the values stay a map, got %T", state.Values.UnderlyingValue())
+	assert.Equal(t, types.StringType, refreshed.ElementType(ctx))
+	assert.Equal(t, map[string]attr.Value{
+		"language": types.StringValue("Go"),
+		"replicas": types.StringValue("3"),
+		"public":   types.StringValue("true"),
+	}, refreshed.Elements(), "properties set outside of Terraform are converted to the element type")
+
+	e.Properties["tags"] = []any{"api"}
+	require.NoError(t, r.refreshEntityState(ctx, state, e, blueprint))
+	object, ok := state.Values.UnderlyingValue().(types.Object)
+	require.True(t, ok, "values that don't fit the element type turn the map into an object, got %T", state.Values.UnderlyingValue())
+	assert.Equal(t, types.StringValue("3"), object.Attributes()["replicas"])
+	assert.Contains(t, object.Attributes(), "tags")
+}
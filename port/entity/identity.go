@@ -0,0 +1,52 @@
+package entity
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.ResourceWithIdentity = &EntityResource{}
+
+type EntityIdentityModel struct {
+	Blueprint  types.String `tfsdk:"blueprint"`
+	Identifier types.String `tfsdk:"identifier"`
+}
+
+func (r *EntityResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"blueprint": identityschema.StringAttribute{
+				Description:       "The blueprint identifier the entity relates to",
+				RequiredForImport: true,
+			},
+			"identifier": identityschema.StringAttribute{
+				Description:       "The identifier of the entity",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func entityIdentity(blueprint string, identifier string) *EntityIdentityModel {
+	return &EntityIdentityModel{
+		Blueprint:  types.StringValue(blueprint),
+		Identifier: types.StringValue(identifier),
+	}
+}
+
+// importStateFromIdentity imports an entity by the identity Terraform passes when the import block sets `identity`
+// instead of `id`, e.g. in the configuration generated by `terraform query`.
+func importStateFromIdentity(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var identity *EntityIdentityModel
+	resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("blueprint"), identity.Blueprint)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("identifier"), identity.Identifier)...)
+}
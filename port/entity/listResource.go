@@ -0,0 +1,141 @@
+package entity
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/flex"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/utils"
+)
+
+var _ list.ListResourceWithConfigure = &EntityListResource{}
+
+func NewEntityListResource() list.ListResource {
+	return &EntityListResource{}
+}
+
+// EntityListResource lists the entities of a blueprint for `terraform query`, so they can be imported in bulk.
+type EntityListResource struct {
+	portClient *cli.PortClient
+}
+
+type EntityListModel struct {
+	Blueprint types.String `tfsdk:"blueprint"`
+	Query     types.String `tfsdk:"query"`
+}
+
+func (r *EntityListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_entity"
+}
+
+func (r *EntityListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.portClient = req.ProviderData.(*cli.PortClient)
+}
+
+func (r *EntityListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the entities of a blueprint",
+		Attributes: map[string]schema.Attribute{
+			"blueprint": schema.StringAttribute{
+				MarkdownDescription: "The identifier of the blueprint to list the entities of",
+				Required:            true,
+			},
+			"query": schema.StringAttribute{
+				MarkdownDescription: "A search query in JSON format, with a `combinator` and `rules`, that the listed entities must also match",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *EntityListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config EntityListModel
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	searchRequest, err := entityListToSearchRequest(&config, req.Limit)
+	if err != nil {
+		diags.AddError("failed to convert the query to a search request", err.Error())
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	searchResult, err := r.portClient.Search(ctx, searchRequest)
+	if err != nil {
+		diags.AddError("failed to search entities", err.Error())
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	var blueprint *cli.Blueprint
+	if req.IncludeResource {
+		blueprint, _, err = r.portClient.ReadBlueprint(ctx, config.Blueprint.ValueString())
+		if err != nil {
+			diags.AddError("failed to read blueprint", err.Error())
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	entityResource := &EntityResource{portClient: r.portClient}
+	stream.Results = func(push func(list.ListResult) bool) {
+		for _, e := range searchResult.Entities {
+			result := req.NewListResult(ctx)
+			result.DisplayName = e.Title
+			if result.DisplayName == "" {
+				result.DisplayName = e.Identifier
+			}
+			result.Diagnostics.Append(result.Identity.Set(ctx, entityIdentity(e.Blueprint, e.Identifier))...)
+
+			if req.IncludeResource && !result.Diagnostics.HasError() {
+				state := &EntityModel{Timeouts: flex.NullTimeouts(ctx, timeoutsOpts)}
+				if err := entityResource.refreshEntityState(ctx, state, &e, blueprint); err != nil {
+					result.Diagnostics.AddError("failed writing entity fields to resource", err.Error())
+				} else {
+					result.Diagnostics.Append(result.Resource.Set(ctx, state)...)
+				}
+			}
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
+
+// entityListToSearchRequest searches the entities of the blueprint that match the query of the list block, if any.
+func entityListToSearchRequest(config *EntityListModel, limit int64) (*cli.SearchRequestQuery, error) {
+	rules := []any{
+		map[string]any{"property": "$blueprint", "operator": "=", "value": config.Blueprint.ValueString()},
+	}
+
+	query, err := utils.TerraformJsonStringToGoObject(config.Query.ValueStringPointer())
+	if err != nil {
+		return nil, err
+	}
+	if query != nil {
+		rules = append(rules, *query)
+	}
+
+	var maxResults *int
+	if limit > 0 {
+		maxResults = utils.PtrTo(int(limit))
+	}
+
+	return &cli.SearchRequestQuery{
+		Query:                       &map[string]any{"combinator": "and", "rules": rules},
+		ExcludeCalculatedProperties: utils.PtrTo(true),
+		MaxResults:                  maxResults,
+	}, nil
+}
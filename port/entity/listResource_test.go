@@ -0,0 +1,56 @@
+package entity_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/acctest"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/acctest/fakeclient"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPortEntityList(t *testing.T) {
+	ctx := context.Background()
+	server, client := fakeclient.New(t)
+
+	for _, identifier := range []string{"microservice", "environment"} {
+		_, err := client.CreateBlueprint(ctx, &cli.Blueprint{
+			Identifier: identifier,
+			Title:      identifier,
+			Schema: cli.BlueprintSchema{Properties: map[string]cli.BlueprintProperty{
+				"language": {Type: "string", Title: utils.PtrTo("Language")},
+			}},
+		}, nil)
+		require.NoError(t, err)
+	}
+	for _, e := range []cli.Entity{
+		{Identifier: "api", Title: "API", Blueprint: "microservice", Properties: map[string]any{"language": "Go"}},
+		{Identifier: "web", Title: "Web", Blueprint: "microservice", Properties: map[string]any{"language": "TypeScript"}},
+		{Identifier: "production", Blueprint: "environment"},
+	} {
+		_, err := client.CreateEntity(ctx, &e, "", false)
+		require.NoError(t, err)
+	}
+
+	results := acctest.List(t, server, "port_entity", map[string]tftypes.Value{
+		"blueprint": tftypes.NewValue(tftypes.String, "microservice"),
+	}, false)
+	require.Len(t, results, 2, "only the entities of the blueprint are listed")
+	assert.Equal(t, "API", results[0].DisplayName)
+	assert.Equal(t, tftypes.NewValue(tftypes.String, "microservice"), results[0].Identity["blueprint"])
+	assert.Equal(t, tftypes.NewValue(tftypes.String, "api"), results[0].Identity["identifier"])
+	assert.Nil(t, results[0].Resource)
+
+	results = acctest.List(t, server, "port_entity", map[string]tftypes.Value{
+		"blueprint": tftypes.NewValue(tftypes.String, "microservice"),
+		"query":     tftypes.NewValue(tftypes.String, `{"combinator": "and", "rules": [{"property": "language", "operator": "=", "value": "TypeScript"}]}`),
+	}, true)
+	require.Len(t, results, 1)
+	assert.Equal(t, tftypes.NewValue(tftypes.String, "web"), results[0].Identity["identifier"])
+	assert.Equal(t, tftypes.NewValue(tftypes.String, "microservice:web"), results[0].Resource["id"])
+	assert.Equal(t, tftypes.NewValue(tftypes.String, "Web"), results[0].Resource["title"])
+}
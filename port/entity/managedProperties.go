@@ -0,0 +1,195 @@
+package entity
+
+import (
+	"context"
+	"maps"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
+)
+
+const (
+	// managedPropertiesAll manages the whole entity, the properties and relations that aren't in the configuration are
+	// removed on update and show up as drift.
+	managedPropertiesAll = "all"
+	// managedPropertiesDeclared manages only the properties and relations in the configuration, so others, like the
+	// ones an integration owns, are left as they are.
+	managedPropertiesDeclared = "declared"
+)
+
+func managesDeclaredProperties(state *EntityModel) bool {
+	return state.ManagedProperties.ValueString() == managedPropertiesDeclared
+}
+
+// upsertDeclaredEntity creates the entity, or patches it if it already exists, so the properties and relations that
+// aren't in the body are kept.
+func (r *EntityResource) upsertDeclaredEntity(ctx context.Context, e *cli.Entity, runID string, createMissingRelatedEntities bool) (*cli.Entity, error) {
+	if e.Identifier == "" {
+		return r.portClient.CreateEntity(ctx, e, runID, createMissingRelatedEntities)
+	}
+
+	_, statusCode, err := r.portClient.ReadEntity(ctx, e.Identifier, e.Blueprint, true)
+	if err != nil {
+		if statusCode == 404 {
+			return r.portClient.CreateEntity(ctx, e, runID, createMissingRelatedEntities)
+		}
+		return nil, err
+	}
+	return r.portClient.PatchEntity(ctx, e.Identifier, e.Blueprint, e, runID, createMissingRelatedEntities)
+}
+
+// declaredKeys returns the identifiers of the properties and relations in the state, whether they are set with
+// `properties` or `values`.
+func declaredKeys(state *EntityModel) (map[string]bool, map[string]bool) {
+	properties := map[string]bool{}
+	relations := map[string]bool{}
+
+	if state.Properties != nil {
+		for _, keys := range []map[string]bool{
+			keysOf(state.Properties.StringProps),
+			keysOf(state.Properties.NumberProps),
+			keysOf(state.Properties.BooleanProps),
+			keysOf(state.Properties.ObjectProps),
+		} {
+			maps.Copy(properties, keys)
+		}
+		if state.Properties.ArrayProps != nil {
+			for _, items := range []types.Map{
+				state.Properties.ArrayProps.StringItems,
+				state.Properties.ArrayProps.NumberItems,
+				state.Properties.ArrayProps.BooleanItems,
+				state.Properties.ArrayProps.ObjectItems,
+			} {
+				maps.Copy(properties, keysOf(items.Elements()))
+			}
+		}
+	}
+
+	if attributes, ok := valuesAttributes(state.Values); ok {
+		maps.Copy(properties, keysOf(attributes))
+	}
+
+	if state.Relations != nil {
+		maps.Copy(relations, keysOf(state.Relations.SingleRelation))
+		maps.Copy(relations, keysOf(state.Relations.ManyRelations))
+	}
+
+	return properties, relations
+}
+
+// clearRemovedKeys sets the properties and relations that the previous state declared and the plan no longer does to
+// null in the body, so patching the entity removes them instead of keeping their last value.
+func clearRemovedKeys(e *cli.Entity, previousState, state *EntityModel) {
+	previousProperties, previousRelations := declaredKeys(previousState)
+	properties, relations := declaredKeys(state)
+
+	for identifier := range previousProperties {
+		if !properties[identifier] {
+			if e.Properties == nil {
+				e.Properties = map[string]any{}
+			}
+			e.Properties[identifier] = nil
+		}
+	}
+	for identifier := range previousRelations {
+		if !relations[identifier] {
+			if e.Relations == nil {
+				e.Relations = map[string]any{}
+			}
+			e.Relations[identifier] = nil
+		}
+	}
+}
+
+// removeUndeclared removes the properties and relations that weren't declared from the refreshed state, so they
+// don't show up as drift.
+func removeUndeclared(ctx context.Context, state *EntityModel, properties map[string]bool, relations map[string]bool) {
+	undeclaredProperty := func(identifier string) bool { return !properties[identifier] }
+	undeclaredRelation := func(identifier string) bool { return !relations[identifier] }
+
+	if state.Properties != nil {
+		state.Properties.StringProps = deleteKeys(state.Properties.StringProps, undeclaredProperty)
+		state.Properties.NumberProps = deleteKeys(state.Properties.NumberProps, undeclaredProperty)
+		state.Properties.BooleanProps = deleteKeys(state.Properties.BooleanProps, undeclaredProperty)
+		state.Properties.ObjectProps = deleteKeys(state.Properties.ObjectProps, undeclaredProperty)
+
+		if arrayProps := state.Properties.ArrayProps; arrayProps != nil {
+			arrayProps.StringItems = deleteMapElements(ctx, arrayProps.StringItems, undeclaredProperty)
+			arrayProps.NumberItems = deleteMapElements(ctx, arrayProps.NumberItems, undeclaredProperty)
+			arrayProps.BooleanItems = deleteMapElements(ctx, arrayProps.BooleanItems, undeclaredProperty)
+			arrayProps.ObjectItems = deleteMapElements(ctx, arrayProps.ObjectItems, undeclaredProperty)
+			if arrayProps.StringItems.IsNull() && arrayProps.NumberItems.IsNull() && arrayProps.BooleanItems.IsNull() && arrayProps.ObjectItems.IsNull() {
+				state.Properties.ArrayProps = nil
+			}
+		}
+
+		if state.Properties.StringProps == nil && state.Properties.NumberProps == nil && state.Properties.BooleanProps == nil &&
+			state.Properties.ObjectProps == nil && state.Properties.ArrayProps == nil {
+			state.Properties = nil
+		}
+	}
+
+	if _, ok := valuesAttributes(state.Values); ok {
+		switch values := state.Values.UnderlyingValue().(type) {
+		case basetypes.ObjectValue:
+			attributeTypes := values.AttributeTypes(ctx)
+			attributes := values.Attributes()
+			maps.DeleteFunc(attributeTypes, func(identifier string, _ attr.Type) bool { return undeclaredProperty(identifier) })
+			maps.DeleteFunc(attributes, func(identifier string, _ attr.Value) bool { return undeclaredProperty(identifier) })
+			filtered, diags := types.ObjectValue(attributeTypes, attributes)
+			if !diags.HasError() {
+				state.Values = types.DynamicValue(filtered)
+			}
+		case basetypes.MapValue:
+			elements := maps.Clone(values.Elements())
+			maps.DeleteFunc(elements, func(identifier string, _ attr.Value) bool { return undeclaredProperty(identifier) })
+			filtered, diags := types.MapValue(values.ElementType(ctx), elements)
+			if !diags.HasError() {
+				state.Values = types.DynamicValue(filtered)
+			}
+		}
+	}
+
+	if state.Relations != nil {
+		state.Relations.SingleRelation = deleteKeys(state.Relations.SingleRelation, undeclaredRelation)
+		state.Relations.ManyRelations = deleteKeys(state.Relations.ManyRelations, undeclaredRelation)
+		if state.Relations.SingleRelation == nil && state.Relations.ManyRelations == nil {
+			state.Relations = nil
+		}
+	}
+}
+
+func keysOf[V any](m map[string]V) map[string]bool {
+	keys := make(map[string]bool, len(m))
+	for k := range m {
+		keys[k] = true
+	}
+	return keys
+}
+
+// deleteKeys deletes the keys that match del, and returns nil instead of an empty map, like the refresh does.
+func deleteKeys[V any](m map[string]V, del func(string) bool) map[string]V {
+	maps.DeleteFunc(m, func(k string, _ V) bool { return del(k) })
+	if len(m) == 0 {
+		return nil
+	}
+	return m
+}
+
+func deleteMapElements(ctx context.Context, m types.Map, del func(string) bool) types.Map {
+	if m.IsNull() || m.IsUnknown() {
+		return m
+	}
+	elements := maps.Clone(m.Elements())
+	maps.DeleteFunc(elements, func(k string, _ attr.Value) bool { return del(k) })
+	if len(elements) == 0 {
+		return types.MapNull(m.ElementType(ctx))
+	}
+	filtered, diags := types.MapValue(m.ElementType(ctx), elements)
+	if diags.HasError() {
+		return m
+	}
+	return filtered
+}
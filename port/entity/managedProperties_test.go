@@ -0,0 +1,118 @@
+package entity
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/acctest/fakeclient"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEntityDeclaredManagedProperties(t *testing.T) {
+	ctx := context.Background()
+	_, client := fakeclient.New(t)
+
+	_, err := client.CreateBlueprint(ctx, &cli.Blueprint{Identifier: "team", Title: "Team"}, nil)
+	require.NoError(t, err)
+	bp, err := client.CreateBlueprint(ctx, &cli.Blueprint{
+		Identifier: "microservice",
+		Title:      "Microservice",
+		Schema: cli.BlueprintSchema{Properties: map[string]cli.BlueprintProperty{
+			"language": {Type: "string", Title: utils.PtrTo("Language")},
+			"stars":    {Type: "number", Title: utils.PtrTo("Stars")},
+			"topics":   {Type: "array", Title: utils.PtrTo("Topics")},
+		}},
+		Relations: map[string]cli.Relation{
+			"owner":      {Target: utils.PtrTo("team")},
+			"developers": {Target: utils.PtrTo("team"), Many: utils.PtrTo(true)},
+		},
+	}, nil)
+	require.NoError(t, err)
+	for _, identifier := range []string{"platform", "payments"} {
+		_, err = client.CreateEntity(ctx, &cli.Entity{Identifier: identifier, Blueprint: "team"}, "", false)
+		require.NoError(t, err)
+	}
+	// An integration owns the stars, the topics and the developers of the entity.
+	_, err = client.CreateEntity(ctx, &cli.Entity{
+		Identifier: "api",
+		Title:      "API",
+		Blueprint:  "microservice",
+		Properties: map[string]any{"language": "Go", "stars": 42, "topics": []any{"payments"}},
+		Relations:  map[string]any{"developers": []any{"payments"}},
+	}, "", false)
+	require.NoError(t, err)
+
+	owner := "platform"
+	state := &EntityModel{
+		Identifier: types.StringValue("api"),
+		Blueprint:  types.StringValue("microservice"),
+		Title:      types.StringValue("API"),
+		Properties: &EntityPropertiesModel{
+			StringProps: map[string]types.String{"language": types.StringValue("Rust")},
+		},
+		Relations:         &RelationModel{SingleRelation: map[string]*string{"owner": &owner}},
+		ManagedProperties: types.StringValue(managedPropertiesDeclared),
+	}
+	r := &EntityResource{portClient: client}
+
+	body, err := entityResourceToBody(ctx, state, bp)
+	require.NoError(t, err)
+	en, err := r.upsertDeclaredEntity(ctx, body, "", false)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{"language": "Rust", "stars": float64(42), "topics": []any{"payments"}}, en.Properties,
+		"the properties that aren't declared are kept")
+	assert.Equal(t, map[string]any{"owner": "platform", "developers": []any{"payments"}}, en.Relations)
+
+	require.NoError(t, r.refreshEntityState(ctx, state, en, bp))
+	assert.Equal(t, &EntityPropertiesModel{
+		StringProps: map[string]types.String{"language": types.StringValue("Rust")},
+	}, state.Properties, "the properties that aren't declared are ignored")
+	assert.Equal(t, &RelationModel{SingleRelation: map[string]*string{"owner": &owner}}, state.Relations)
+
+	// Removing the owner from the configuration removes it from the entity, and the values map replaces the
+	// properties.
+	values, diags := types.MapValue(types.StringType, map[string]attr.Value{"language": types.StringValue("Go")})
+	require.False(t, diags.HasError())
+	previousState := state
+	state = &EntityModel{
+		Identifier:        types.StringValue("api"),
+		Blueprint:         types.StringValue("microservice"),
+		Title:             types.StringValue("API"),
+		Values:            types.DynamicValue(values),
+		ManagedProperties: types.StringValue(managedPropertiesDeclared),
+	}
+	properties, relations := declaredKeys(state)
+	assert.Equal(t, map[string]bool{"language": true}, properties, "the keys of a values map are declared")
+	assert.Empty(t, relations)
+
+	body, err = entityResourceToBody(ctx, state, bp)
+	require.NoError(t, err)
+	clearRemovedKeys(body, previousState, state)
+	assert.Equal(t, map[string]any{"owner": nil}, body.Relations)
+	en, err = client.PatchEntity(ctx, "api", "microservice", body, "", false)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{"language": "Go", "stars": float64(42), "topics": []any{"payments"}}, en.Properties)
+	assert.Nil(t, en.Relations["owner"], "the relation that's no longer declared is removed")
+	assert.Equal(t, []any{"payments"}, en.Relations["developers"])
+
+	require.NoError(t, r.refreshEntityState(ctx, state, en, bp))
+	assert.Equal(t, types.DynamicValue(values), state.Values, "the values map keeps only its declared keys")
+	assert.Nil(t, state.Relations)
+
+	state.ManagedProperties = types.StringValue(managedPropertiesAll)
+	state.Values = types.DynamicNull()
+	state.Properties = previousState.Properties
+	require.NoError(t, r.refreshEntityState(ctx, state, en, bp))
+	assert.Equal(t, types.Float64Value(42), state.Properties.NumberProps["stars"])
+	assert.Equal(t, []string{"payments"}, state.Relations.ManyRelations["developers"])
+
+	imported := &EntityModel{Identifier: types.StringValue("api"), Blueprint: types.StringValue("microservice")}
+	require.NoError(t, r.refreshEntityState(ctx, imported, en, bp))
+	assert.Equal(t, types.StringValue(managedPropertiesAll), imported.ManagedProperties, "an imported entity manages the whole entity")
+	assert.Equal(t, types.Float64Value(42), imported.Properties.NumberProps["stars"])
+}
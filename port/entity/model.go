@@ -1,6 +1,7 @@
 package entity
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -36,7 +37,43 @@ type EntityModel struct {
 	UpdatedAt                    types.String           `tfsdk:"updated_at"`
 	UpdatedBy                    types.String           `tfsdk:"updated_by"`
 	Properties                   *EntityPropertiesModel `tfsdk:"properties"`
+	Values                       types.Dynamic          `tfsdk:"values"`
 	Teams                        []types.String         `tfsdk:"teams"`
 	Relations                    *RelationModel         `tfsdk:"relations"`
 	CreateMissingRelatedEntities types.Bool             `tfsdk:"create_missing_related_entities"`
+	ManagedProperties            types.String           `tfsdk:"managed_properties"`
+	Timeouts                     timeouts.Value         `tfsdk:"timeouts"`
+}
+
+type EntityDataSourceModel struct {
+	ID                          types.String           `tfsdk:"id"`
+	Identifier                  types.String           `tfsdk:"identifier"`
+	Blueprint                   types.String           `tfsdk:"blueprint"`
+	IncludeCalculatedProperties types.Bool             `tfsdk:"include_calculated_properties"`
+	Title                       types.String           `tfsdk:"title"`
+	Icon                        types.String           `tfsdk:"icon"`
+	CreatedAt                   types.String           `tfsdk:"created_at"`
+	CreatedBy                   types.String           `tfsdk:"created_by"`
+	UpdatedAt                   types.String           `tfsdk:"updated_at"`
+	UpdatedBy                   types.String           `tfsdk:"updated_by"`
+	Properties                  *EntityPropertiesModel `tfsdk:"properties"`
+	Teams                       []types.String         `tfsdk:"teams"`
+	Relations                   *RelationModel         `tfsdk:"relations"`
+}
+
+// EntitiesEntityModel is an entity of the port_entities resource. Its identifier and blueprint are the key of the
+// `entities` map and the blueprint of the resource.
+type EntitiesEntityModel struct {
+	Title      types.String           `tfsdk:"title"`
+	Icon       types.String           `tfsdk:"icon"`
+	Teams      []types.String         `tfsdk:"teams"`
+	Properties *EntityPropertiesModel `tfsdk:"properties"`
+	Relations  *RelationModel         `tfsdk:"relations"`
+}
+
+type EntitiesModel struct {
+	ID                           types.String                    `tfsdk:"id"`
+	Blueprint                    types.String                    `tfsdk:"blueprint"`
+	CreateMissingRelatedEntities types.Bool                      `tfsdk:"create_missing_related_entities"`
+	Entities                     map[string]*EntitiesEntityModel `tfsdk:"entities"`
 }
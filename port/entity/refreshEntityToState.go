@@ -3,6 +3,7 @@ package entity
 import (
 	"context"
 	"fmt"
+	"maps"
 
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
@@ -179,10 +180,17 @@ func refreshRelationsEntityState(ctx context.Context, state *EntityModel, e *cli
 }
 
 func (r *EntityResource) refreshEntityState(ctx context.Context, state *EntityModel, e *cli.Entity, blueprint *cli.Blueprint) error {
+	// The declared properties and relations are taken from the prior state, before the refresh replaces them.
+	declaredProperties, declaredRelations := declaredKeys(state)
+
 	state.ID = types.StringValue(fmt.Sprintf("%s:%s", blueprint.Identifier, e.Identifier))
 	state.Identifier = types.StringValue(e.Identifier)
 	state.Blueprint = types.StringValue(blueprint.Identifier)
 	state.Title = types.StringValue(e.Title)
+	// Imported entities, and the ones created before managed_properties existed, manage the whole entity.
+	if state.ManagedProperties.IsNull() {
+		state.ManagedProperties = types.StringValue(managedPropertiesAll)
+	}
 
 	if e.Icon != "" {
 		state.Icon = types.StringValue(e.Icon)
@@ -204,7 +212,21 @@ func (r *EntityResource) refreshEntityState(ctx context.Context, state *EntityMo
 		}
 	}
 
-	if len(e.Properties) == 0 {
+	if !state.Values.IsNull() {
+		// Entities whose properties are set with `values` keep them there.
+		properties := e.Properties
+		if managesDeclaredProperties(state) {
+			// Undeclared properties are left out before the refresh, so they can't turn a values map into an object.
+			properties = maps.Clone(e.Properties)
+			maps.DeleteFunc(properties, func(identifier string, _ any) bool { return !declaredProperties[identifier] })
+		}
+		values, err := refreshValuesEntityState(ctx, state.Values, properties, blueprint)
+		if err != nil {
+			return err
+		}
+		state.Values = values
+		state.Properties = nil
+	} else if len(e.Properties) == 0 {
 		state.Properties = nil
 	} else {
 		r.refreshPropertiesEntityState(ctx, state, e, blueprint)
@@ -216,5 +238,9 @@ func (r *EntityResource) refreshEntityState(ctx context.Context, state *EntityMo
 		refreshRelationsEntityState(ctx, state, e)
 	}
 
+	if managesDeclaredProperties(state) {
+		removeUndeclared(ctx, state, declaredProperties, declaredRelations)
+	}
+
 	return nil
 }
@@ -9,11 +9,13 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/consts"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces
 var _ resource.Resource = &EntityResource{}
 var _ resource.ResourceWithImportState = &EntityResource{}
+var _ resource.ResourceWithConfigValidators = &EntityResource{}
 
 func NewEntityResource() resource.Resource {
 	return &EntityResource{}
@@ -25,6 +27,8 @@ type EntityResource struct {
 
 func (r *EntityResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_entity"
+	// Changing the blueprint or the identifier of an entity moves it, so its identity can change.
+	resp.ResourceBehavior.MutableIdentity = true
 }
 
 func (r *EntityResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -45,7 +49,8 @@ func (r *EntityResource) Read(ctx context.Context, req resource.ReadRequest, res
 	}
 
 	blueprintIdentifier := state.Blueprint.ValueString()
-	e, statusCode, err := r.portClient.ReadEntity(ctx, state.Identifier.ValueString(), state.Blueprint.ValueString())
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, entityIdentity(blueprintIdentifier, state.Identifier.ValueString()))...)
+	e, statusCode, err := r.portClient.ReadEntity(ctx, state.Identifier.ValueString(), state.Blueprint.ValueString(), true)
 	if err != nil {
 		if statusCode == 404 {
 			resp.State.RemoveResource(ctx)
@@ -76,6 +81,14 @@ func (r *EntityResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	createTimeout, diags := state.Timeouts.Create(ctx, consts.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	bp, _, err := r.portClient.ReadBlueprint(ctx, state.Blueprint.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("failed to read blueprint", err.Error())
@@ -95,7 +108,12 @@ func (r *EntityResource) Create(ctx context.Context, req resource.CreateRequest,
 
 	createMissingRelatedEntities := !state.CreateMissingRelatedEntities.IsNull() && state.CreateMissingRelatedEntities.ValueBool()
 
-	en, err := r.portClient.CreateEntity(ctx, e, runID, createMissingRelatedEntities)
+	var en *cli.Entity
+	if managesDeclaredProperties(state) {
+		en, err = r.upsertDeclaredEntity(ctx, e, runID, createMissingRelatedEntities)
+	} else {
+		en, err = r.portClient.CreateEntity(ctx, e, runID, createMissingRelatedEntities)
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("failed to create entity", err.Error())
 		return
@@ -104,6 +122,7 @@ func (r *EntityResource) Create(ctx context.Context, req resource.CreateRequest,
 	writeEntityComputedFieldsToState(state, en)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, entityIdentity(en.Blueprint, en.Identifier))...)
 }
 
 func writeEntityComputedFieldsToState(state *EntityModel, e *cli.Entity) {
@@ -126,6 +145,14 @@ func (r *EntityResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	updateTimeout, diags := state.Timeouts.Update(ctx, consts.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	bp, _, err := r.portClient.ReadBlueprint(ctx, state.Blueprint.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("failed to read blueprint", err.Error())
@@ -149,9 +176,15 @@ func (r *EntityResource) Update(ctx context.Context, req resource.UpdateRequest,
 
 	isBlueprintChanged := !previousState.Blueprint.IsNull() && previousState.Blueprint.ValueString() != state.Blueprint.ValueString()
 
-	if previousState.Identifier.IsNull() || isBlueprintChanged {
+	switch {
+	case (previousState.Identifier.IsNull() || isBlueprintChanged) && managesDeclaredProperties(state):
+		en, err = r.upsertDeclaredEntity(ctx, e, runID, createMissingRelatedEntities)
+	case previousState.Identifier.IsNull() || isBlueprintChanged:
 		en, err = r.portClient.CreateEntity(ctx, e, runID, createMissingRelatedEntities)
-	} else {
+	case managesDeclaredProperties(state):
+		clearRemovedKeys(e, previousState, state)
+		en, err = r.portClient.PatchEntity(ctx, previousState.Identifier.ValueString(), previousState.Blueprint.ValueString(), e, runID, createMissingRelatedEntities)
+	default:
 		en, err = r.portClient.UpdateEntity(ctx, previousState.Identifier.ValueString(), previousState.Blueprint.ValueString(), e, runID, createMissingRelatedEntities)
 	}
 
@@ -172,6 +205,7 @@ func (r *EntityResource) Update(ctx context.Context, req resource.UpdateRequest,
 	writeEntityComputedFieldsToState(state, en)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, entityIdentity(en.Blueprint, en.Identifier))...)
 }
 
 func (r *EntityResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -181,6 +215,14 @@ func (r *EntityResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, consts.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	err := r.portClient.DeleteEntity(ctx, state.Identifier.ValueString(), state.Blueprint.ValueString())
 
 	if err != nil {
@@ -190,6 +232,11 @@ func (r *EntityResource) Delete(ctx context.Context, req resource.DeleteRequest,
 }
 
 func (r *EntityResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		importStateFromIdentity(ctx, req, resp)
+		return
+	}
+
 	idParts := strings.Split(req.ID, ":")
 
 	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
@@ -6,11 +6,10 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/port-labs/terraform-provider-port-labs/v2/internal/acctest"
-	"github.com/port-labs/terraform-provider-port-labs/v2/internal/utils"
 )
 
 func TestAccPortEntity(t *testing.T) {
-	identifier := utils.GenID()
+	identifier := acctest.GenID(t)
 	var testAccActionConfigCreate = fmt.Sprintf(`
 	resource "port_blueprint" "microservice" {
 		title = "TF Provider Test BP0"
@@ -94,7 +93,7 @@ func TestAccPortEntity(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 
 		Steps: []resource.TestStep{
 			{
@@ -122,7 +121,7 @@ func TestAccPortEntity(t *testing.T) {
 }
 
 func TestAccPortEntityWithNulls(t *testing.T) {
-	identifier := utils.GenID()
+	identifier := acctest.GenID(t)
 	var testAccActionConfigCreate = fmt.Sprintf(`
 	resource "port_blueprint" "microservice" {
 		title = "TF Provider Test BP0"
@@ -205,7 +204,7 @@ func TestAccPortEntityWithNulls(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 
 		Steps: []resource.TestStep{
 			{
@@ -228,8 +227,8 @@ func TestAccPortEntityWithNulls(t *testing.T) {
 }
 
 func TestAccPortEntityWithRelation(t *testing.T) {
-	identifier := utils.GenID()
-	identifier2 := utils.GenID()
+	identifier := acctest.GenID(t)
+	identifier2 := acctest.GenID(t)
 	var testAccActionConfigCreate = fmt.Sprintf(`
 	resource "port_blueprint" "microservice" {
 		title = "TF Provider Test BP0"
@@ -291,7 +290,7 @@ func TestAccPortEntityWithRelation(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 
 		Steps: []resource.TestStep{
 			{
@@ -308,8 +307,8 @@ func TestAccPortEntityWithRelation(t *testing.T) {
 }
 
 func TestAccPortEntityWithManyRelation(t *testing.T) {
-	identifier1 := utils.GenID()
-	identifier2 := utils.GenID()
+	identifier1 := acctest.GenID(t)
+	identifier2 := acctest.GenID(t)
 	var testAccActionConfigCreate = fmt.Sprintf(`
 	resource "port_blueprint" "microservice" {
 		title = "TF Provider Test BP0"
@@ -383,7 +382,7 @@ func TestAccPortEntityWithManyRelation(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 
 		Steps: []resource.TestStep{
 			{
@@ -401,8 +400,8 @@ func TestAccPortEntityWithManyRelation(t *testing.T) {
 }
 
 func TestAccPortEntityWithEmptyRelation(t *testing.T) {
-	identifier := utils.GenID()
-	identifier2 := utils.GenID()
+	identifier := acctest.GenID(t)
+	identifier2 := acctest.GenID(t)
 	var testAccActionConfigCreate = fmt.Sprintf(`
 	resource "port_blueprint" "microservice" {
 		title = "TF Provider Test BP0"
@@ -453,7 +452,7 @@ func TestAccPortEntityWithEmptyRelation(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 
 		Steps: []resource.TestStep{
 			{
@@ -470,8 +469,8 @@ func TestAccPortEntityWithEmptyRelation(t *testing.T) {
 }
 
 func TestAccPortEntityImport(t *testing.T) {
-	blueprintIdentifier := utils.GenID()
-	entityIdentifier := utils.GenID()
+	blueprintIdentifier := acctest.GenID(t)
+	entityIdentifier := acctest.GenID(t)
 	entityId := fmt.Sprintf("%s:%s", blueprintIdentifier, entityIdentifier)
 	var testAccActionConfigCreate = fmt.Sprintf(`
 	resource "port_blueprint" "microservice" {
@@ -499,7 +498,7 @@ func TestAccPortEntityImport(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 
 		Steps: []resource.TestStep{
 			{
@@ -523,10 +522,84 @@ func TestAccPortEntityImport(t *testing.T) {
 	})
 }
 
+func TestAccPortEntityImportManagedProperties(t *testing.T) {
+	for _, managedProperties := range []string{"all", "declared"} {
+		t.Run(managedProperties, func(t *testing.T) {
+			blueprintIdentifier := acctest.GenID(t)
+			entityIdentifier := acctest.GenID(t)
+			entityId := fmt.Sprintf("%s:%s", blueprintIdentifier, entityIdentifier)
+			var testAccActionConfigCreate = fmt.Sprintf(`
+	resource "port_blueprint" "microservice" {
+		title = "TF Provider Test BP0"
+		icon = "Terraform"
+		identifier = "%s"
+		properties = {
+			"string_props" = {
+				"myStringIdentifier" =  {
+					"title" = "My String Identifier"
+				}
+			}
+		}
+	}
+	resource "port_entity" "microservice" {
+		title = "TF Provider Test Entity0"
+		blueprint = port_blueprint.microservice.identifier
+		identifier = "%s"
+		managed_properties = "%s"
+		properties = {
+			"string_props" = {
+				"myStringIdentifier" =  "My String Value"
+			}
+		}
+	}`, blueprintIdentifier, entityIdentifier, managedProperties)
+
+			// The API doesn't know how the entity is managed, so imported entities manage all of their properties until
+			// the next apply.
+			importStateVerifyIgnore := []string{"identifier", "create_missing_related_entities"}
+			if managedProperties == "declared" {
+				importStateVerifyIgnore = append(importStateVerifyIgnore, "managed_properties")
+			}
+
+			resource.Test(t, resource.TestCase{
+				PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+				ProtoV6ProviderFactories: acctest.ProviderFactories(t),
+
+				Steps: []resource.TestStep{
+					{
+						Config: acctest.ProviderConfig + testAccActionConfigCreate,
+						Check:  resource.TestCheckResourceAttr("port_entity.microservice", "managed_properties", managedProperties),
+					},
+					{
+						ResourceName:            "port_entity.microservice",
+						ImportState:             true,
+						ImportStateVerify:       true,
+						ImportStateId:           entityId,
+						ImportStateVerifyIgnore: importStateVerifyIgnore,
+					},
+					{
+						Config:             acctest.ProviderConfig + testAccActionConfigCreate,
+						ResourceName:       "port_entity.microservice",
+						ImportState:        true,
+						ImportStateId:      entityId,
+						ImportStatePersist: true,
+					},
+					{
+						Config: acctest.ProviderConfig + testAccActionConfigCreate,
+						Check: resource.ComposeTestCheckFunc(
+							resource.TestCheckResourceAttr("port_entity.microservice", "managed_properties", managedProperties),
+							resource.TestCheckResourceAttr("port_entity.microservice", "properties.string_props.myStringIdentifier", "My String Value"),
+						),
+					},
+				},
+			})
+		})
+	}
+}
+
 func TestAccPortEntityUpdateProp(t *testing.T) {
 
-	identifier := utils.GenID()
-	entityIdentifier := utils.GenID()
+	identifier := acctest.GenID(t)
+	entityIdentifier := acctest.GenID(t)
 	var testAccActionConfigCreate = fmt.Sprintf(`
 	resource "port_blueprint" "microservice" {
 		title = "TF Provider Test BP0"
@@ -577,7 +650,7 @@ func TestAccPortEntityUpdateProp(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 
 		Steps: []resource.TestStep{
 			{
@@ -601,9 +674,9 @@ func TestAccPortEntityUpdateProp(t *testing.T) {
 }
 
 func TestAccPortEntityUpdateIdentifier(t *testing.T) {
-	blueprintIdentifier := utils.GenID()
-	entityIdentifier := utils.GenID()
-	entityUpdatedIdentifier := utils.GenID()
+	blueprintIdentifier := acctest.GenID(t)
+	entityIdentifier := acctest.GenID(t)
+	entityUpdatedIdentifier := acctest.GenID(t)
 	var testAccActionConfigCreate = fmt.Sprintf(`
 	resource "port_blueprint" "microservice" {
 		title = "TF Provider Test BP0"
@@ -654,7 +727,7 @@ func TestAccPortEntityUpdateIdentifier(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 
 		Steps: []resource.TestStep{
 			{
@@ -682,9 +755,9 @@ func TestAccPortEntityUpdateIdentifier(t *testing.T) {
 
 func TestAccPortEntityUpdateBlueprintIdentifier(t *testing.T) {
 
-	blueprintIdentifier := utils.GenID()
-	blueprintIdentifier2 := utils.GenID()
-	entityIdentifier := utils.GenID()
+	blueprintIdentifier := acctest.GenID(t)
+	blueprintIdentifier2 := acctest.GenID(t)
+	entityIdentifier := acctest.GenID(t)
 
 	var testAccActionConfigCreate = fmt.Sprintf(`
 	resource "port_blueprint" "microservice" {
@@ -750,7 +823,7 @@ func TestAccPortEntityUpdateBlueprintIdentifier(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 
 		Steps: []resource.TestStep{
 			{
@@ -776,8 +849,8 @@ func TestAccPortEntityUpdateBlueprintIdentifier(t *testing.T) {
 }
 
 func TestAccPortEntityWithDefaultArrayProp(t *testing.T) {
-	identifier := utils.GenID()
-	entityIdentifier := utils.GenID()
+	identifier := acctest.GenID(t)
+	entityIdentifier := acctest.GenID(t)
 	var testAccActionConfigCreate = fmt.Sprintf(`
 	resource "port_blueprint" "microservice" {
 		title = "TF Provider Test BP0"
@@ -806,7 +879,7 @@ func TestAccPortEntityWithDefaultArrayProp(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 
 		Steps: []resource.TestStep{
 			{
@@ -832,8 +905,8 @@ func TestAccPortEntityWithDefaultArrayProp(t *testing.T) {
 }
 
 func TestAccPortEntityWithMissingRelation(t *testing.T) {
-	identifier := utils.GenID()
-	identifier2 := utils.GenID()
+	identifier := acctest.GenID(t)
+	identifier2 := acctest.GenID(t)
 	testAccActionConfig := fmt.Sprintf(`
     resource "port_blueprint" "microservice" {
         title = "TF Provider Test BP0"
@@ -900,7 +973,7 @@ func TestAccPortEntityWithMissingRelation(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 
 		Steps: []resource.TestStep{
 			{
@@ -920,3 +993,70 @@ func TestAccPortEntityWithMissingRelation(t *testing.T) {
 		},
 	})
 }
+
+func TestAccPortEntityWithValues(t *testing.T) {
+	identifier := acctest.GenID(t)
+	var testAccActionConfigCreate = fmt.Sprintf(`
+	resource "port_blueprint" "microservice" {
+		title = "TF Provider Test BP0"
+		icon = "Terraform"
+		identifier = "%s"
+		properties = {
+			"string_props" = {
+				"language" =  {
+					"title" = "Language"
+				}
+			}
+			"number_props" = {
+				"cpu" =  {
+					"title" = "CPU"
+				}
+			}
+			"object_props" = {
+				"metadata" =  {
+					"title" = "Metadata"
+				}
+			}
+			"array_props" = {
+				"tags" =  {
+					"title" = "Tags"
+					"string_items" = {}
+				}
+			}
+		}
+	}
+	resource "port_entity" "microservice" {
+		title = "TF Provider Test Entity0"
+		blueprint = port_blueprint.microservice.identifier
+		values = {
+			language = "Go"
+			cpu = 0.1
+			metadata = { tier = 1, owners = ["platform"] }
+			tags = ["api", "go"]
+		}
+	}
+	`, identifier)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
+
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig + testAccActionConfigCreate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("port_entity.microservice", "values.language", "Go"),
+					resource.TestCheckResourceAttr("port_entity.microservice", "values.cpu", "0.1"),
+					resource.TestCheckResourceAttr("port_entity.microservice", "values.metadata.tier", "1"),
+					resource.TestCheckResourceAttr("port_entity.microservice", "values.metadata.owners.0", "platform"),
+					resource.TestCheckResourceAttr("port_entity.microservice", "values.tags.1", "go"),
+					resource.TestCheckNoResourceAttr("port_entity.microservice", "properties"),
+				),
+			},
+			{
+				Config:   acctest.ProviderConfig + testAccActionConfigCreate,
+				PlanOnly: true,
+			},
+		},
+	})
+}
@@ -3,11 +3,17 @@ package entity
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -42,6 +48,18 @@ func EntitySchema() map[string]schema.Attribute {
 			Computed:            true,
 			Default:             booldefault.StaticBool(false),
 		},
+		"managed_properties": schema.StringAttribute{
+			MarkdownDescription: "The properties and relations of the entity that Terraform manages. With `all`, every update " +
+				"replaces the whole entity, and properties and relations set outside of Terraform show up as drift. With " +
+				"`declared`, only the properties and relations in the configuration are updated, and the others, like the ones " +
+				"an integration owns, are ignored. Defaults to `all`",
+			Optional: true,
+			Computed: true,
+			Default:  stringdefault.StaticString(managedPropertiesAll),
+			Validators: []validator.String{
+				stringvalidator.OneOf(managedPropertiesAll, managedPropertiesDeclared),
+			},
+		},
 		"teams": schema.SetAttribute{
 			MarkdownDescription: "The teams the entity belongs to",
 			Optional:            true,
@@ -51,70 +69,14 @@ func EntitySchema() map[string]schema.Attribute {
 			MarkdownDescription: "The blueprint identifier the entity relates to",
 			Required:            true,
 		},
-		"properties": schema.SingleNestedAttribute{
-			MarkdownDescription: "The properties of the entity",
-			Optional:            true,
-			Attributes: map[string]schema.Attribute{
-				"string_props": schema.MapAttribute{
-					MarkdownDescription: "The string properties of the entity",
-					Optional:            true,
-					ElementType:         types.StringType,
-				},
-				"number_props": schema.MapAttribute{
-					MarkdownDescription: "The number properties of the entity",
-					Optional:            true,
-					ElementType:         types.Float64Type,
-				},
-				"boolean_props": schema.MapAttribute{
-					MarkdownDescription: "The bool properties of the entity",
-					Optional:            true,
-					ElementType:         types.BoolType,
-				},
-				"object_props": schema.MapAttribute{
-					MarkdownDescription: "The object properties of the entity",
-					Optional:            true,
-					ElementType:         types.StringType,
-				},
-				"array_props": schema.SingleNestedAttribute{
-					MarkdownDescription: "The array properties of the entity",
-					Optional:            true,
-					Attributes: map[string]schema.Attribute{
-						"string_items": schema.MapAttribute{
-							ElementType: types.ListType{ElemType: types.StringType},
-							Optional:    true,
-						},
-						"number_items": schema.MapAttribute{
-							ElementType: types.ListType{ElemType: types.Float64Type},
-							Optional:    true,
-						},
-						"boolean_items": schema.MapAttribute{
-							ElementType: types.ListType{ElemType: types.BoolType},
-							Optional:    true,
-						},
-						"object_items": schema.MapAttribute{
-							ElementType: types.ListType{ElemType: types.StringType},
-							Optional:    true,
-						},
-					},
-				},
-			},
-		},
-		"relations": schema.SingleNestedAttribute{
-			MarkdownDescription: "The relations of the entity",
-			Optional:            true,
-			Attributes: map[string]schema.Attribute{
-				"single_relations": schema.MapAttribute{
-					MarkdownDescription: "The single relation of the entity",
-					Optional:            true,
-					ElementType:         types.StringType,
-				},
-				"many_relations": schema.MapAttribute{
-					MarkdownDescription: "The many relation of the entity",
-					Optional:            true,
-					ElementType:         types.ListType{ElemType: types.StringType},
-				},
-			},
+		"properties": entityPropertiesSchema(),
+		"values": schema.DynamicAttribute{
+			MarkdownDescription: "The properties of the entity as an object of property identifiers to their values, e.g. " +
+				"`{ language = \"Go\", replicas = 3, tags = [\"api\"] }`. Objects and arrays are set as is, instead of as " +
+				"JSON strings. Conflicts with `properties`",
+			Optional: true,
 		},
+		"relations": entityRelationsSchema(),
 		"created_at": schema.StringAttribute{
 			MarkdownDescription: "The creation date of the entity",
 			Computed:            true,
@@ -137,9 +99,90 @@ func EntitySchema() map[string]schema.Attribute {
 	}
 }
 
+func entityPropertiesSchema() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: "The properties of the entity",
+		Optional:            true,
+		Attributes: map[string]schema.Attribute{
+			"string_props": schema.MapAttribute{
+				MarkdownDescription: "The string properties of the entity",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"number_props": schema.MapAttribute{
+				MarkdownDescription: "The number properties of the entity",
+				Optional:            true,
+				ElementType:         types.Float64Type,
+			},
+			"boolean_props": schema.MapAttribute{
+				MarkdownDescription: "The bool properties of the entity",
+				Optional:            true,
+				ElementType:         types.BoolType,
+			},
+			"object_props": schema.MapAttribute{
+				MarkdownDescription: "The object properties of the entity",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"array_props": schema.SingleNestedAttribute{
+				MarkdownDescription: "The array properties of the entity",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"string_items": schema.MapAttribute{
+						ElementType: types.ListType{ElemType: types.StringType},
+						Optional:    true,
+					},
+					"number_items": schema.MapAttribute{
+						ElementType: types.ListType{ElemType: types.Float64Type},
+						Optional:    true,
+					},
+					"boolean_items": schema.MapAttribute{
+						ElementType: types.ListType{ElemType: types.BoolType},
+						Optional:    true,
+					},
+					"object_items": schema.MapAttribute{
+						ElementType: types.ListType{ElemType: types.StringType},
+						Optional:    true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func entityRelationsSchema() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: "The relations of the entity",
+		Optional:            true,
+		Attributes: map[string]schema.Attribute{
+			"single_relations": schema.MapAttribute{
+				MarkdownDescription: "The single relation of the entity",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"many_relations": schema.MapAttribute{
+				MarkdownDescription: "The many relation of the entity",
+				Optional:            true,
+				ElementType:         types.ListType{ElemType: types.StringType},
+			},
+		},
+	}
+}
+
+var timeoutsOpts = timeouts.Opts{Create: true, Update: true, Delete: true}
+
 func (r *EntityResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Entity resource",
 		Attributes:          EntitySchema(),
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeoutsOpts),
+		},
+	}
+}
+
+func (r *EntityResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.Conflicting(path.MatchRoot("properties"), path.MatchRoot("values")),
 	}
 }
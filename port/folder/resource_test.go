@@ -8,11 +8,10 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/port-labs/terraform-provider-port-labs/v2/internal/acctest"
-	"github.com/port-labs/terraform-provider-port-labs/v2/internal/utils"
 )
 
 func TestAccPortFolderResourceBasicBetaEnabled(t *testing.T) {
-	folderIdentifier := utils.GenID()
+	folderIdentifier := acctest.GenID(t)
 	err := os.Setenv("PORT_BETA_FEATURES_ENABLED", "true")
 	if err != nil {
 		t.Fatal(err)
@@ -26,7 +25,7 @@ func TestAccPortFolderResourceBasicBetaEnabled(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: acctest.ProviderConfig + testAccPortFolderResourceBasic,
@@ -39,7 +38,7 @@ func TestAccPortFolderResourceBasicBetaEnabled(t *testing.T) {
 }
 
 func TestAccPortFolderResourceBasicBetaDisabled(t *testing.T) {
-	folderIdentifier := utils.GenID()
+	folderIdentifier := acctest.GenID(t)
 	err := os.Setenv("PORT_BETA_FEATURES_ENABLED", "false")
 	if err != nil {
 		t.Fatal(err)
@@ -53,7 +52,7 @@ func TestAccPortFolderResourceBasicBetaDisabled(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config:      acctest.ProviderConfig + testAccPortFolderResourceBasic,
@@ -64,8 +63,8 @@ func TestAccPortFolderResourceBasicBetaDisabled(t *testing.T) {
 }
 
 func TestAccPortFolderResourceCreateFolderWithParent(t *testing.T) {
-	parentFolderIdentifier := utils.GenID()
-	childFolderIdentifier := utils.GenID()
+	parentFolderIdentifier := acctest.GenID(t)
+	childFolderIdentifier := acctest.GenID(t)
 	err := os.Setenv("PORT_BETA_FEATURES_ENABLED", "true")
 	if err != nil {
 		t.Fatal(err)
@@ -87,7 +86,7 @@ func TestAccPortFolderResourceCreateFolderWithParent(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: acctest.ProviderConfig + testAccPortFolderResourceParent + testAccPortFolderResourceChild,
@@ -104,7 +103,7 @@ func TestAccPortFolderResourceCreateFolderWithParent(t *testing.T) {
 }
 
 func TestAccPortFolderResourceUpdateFolder(t *testing.T) {
-	folderIdentifier := utils.GenID()
+	folderIdentifier := acctest.GenID(t)
 	updatedTitle := "Updated Folder Title"
 	err := os.Setenv("PORT_BETA_FEATURES_ENABLED", "true")
 	if err != nil {
@@ -126,7 +125,7 @@ func TestAccPortFolderResourceUpdateFolder(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: acctest.ProviderConfig + testAccPortFolderResource,
@@ -147,7 +146,7 @@ func TestAccPortFolderResourceUpdateFolder(t *testing.T) {
 }
 
 func TestAccPortFolderResourceAfterNoDrift(t *testing.T) {
-	folderIdentifier := utils.GenID()
+	folderIdentifier := acctest.GenID(t)
 	err := os.Setenv("PORT_BETA_FEATURES_ENABLED", "true")
 	if err != nil {
 		t.Fatal(err)
@@ -161,7 +160,7 @@ func TestAccPortFolderResourceAfterNoDrift(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: acctest.ProviderConfig + testAccPortFolderResource,
@@ -179,8 +178,8 @@ func TestAccPortFolderResourceAfterNoDrift(t *testing.T) {
 }
 
 func TestAccPortFolderResourceAfterExplicitValue(t *testing.T) {
-	folderAIdentifier := utils.GenID()
-	folderBIdentifier := utils.GenID()
+	folderAIdentifier := acctest.GenID(t)
+	folderBIdentifier := acctest.GenID(t)
 	err := os.Setenv("PORT_BETA_FEATURES_ENABLED", "true")
 	if err != nil {
 		t.Fatal(err)
@@ -212,7 +211,7 @@ func TestAccPortFolderResourceAfterExplicitValue(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: acctest.ProviderConfig + testAccPortFolderResourceInitial,
@@ -232,7 +231,7 @@ func TestAccPortFolderResourceAfterExplicitValue(t *testing.T) {
 }
 
 func TestAccPortFolderResourceImport(t *testing.T) {
-	folderIdentifier := utils.GenID()
+	folderIdentifier := acctest.GenID(t)
 	err := os.Setenv("PORT_BETA_FEATURES_ENABLED", "true")
 	if err != nil {
 		t.Fatal(err)
@@ -246,7 +245,7 @@ func TestAccPortFolderResourceImport(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: acctest.ProviderConfig + testAccPortFolderResource,
@@ -0,0 +1,87 @@
+package functions_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/acctest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPortJqFunction(t *testing.T) {
+	document := tftypes.NewValue(tftypes.String, `{"repository": {"name": "api", "topics": ["go", "grpc"], "stars": 42}}`)
+
+	result, funcErr := acctest.CallFunction(t, "jq", document, tftypes.NewValue(tftypes.String, ".repository.name"))
+	require.Nil(t, funcErr)
+	assert.Equal(t, tftypes.NewValue(tftypes.String, "api"), result)
+
+	result, funcErr = acctest.CallFunction(t, "jq", document, tftypes.NewValue(tftypes.String, `{stars: .repository.stars, topic: .repository.topics[0]}`))
+	require.Nil(t, funcErr)
+	objectType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{"stars": tftypes.Number, "topic": tftypes.String}}
+	assert.Equal(t, tftypes.NewValue(objectType, map[string]tftypes.Value{
+		"stars": tftypes.NewValue(tftypes.Number, 42),
+		"topic": tftypes.NewValue(tftypes.String, "go"),
+	}), result)
+
+	result, funcErr = acctest.CallFunction(t, "jq", document, tftypes.NewValue(tftypes.String, ".repository.topics[]"))
+	require.Nil(t, funcErr)
+	tupleType := tftypes.Tuple{ElementTypes: []tftypes.Type{tftypes.String, tftypes.String}}
+	assert.Equal(t, tftypes.NewValue(tupleType, []tftypes.Value{
+		tftypes.NewValue(tftypes.String, "go"),
+		tftypes.NewValue(tftypes.String, "grpc"),
+	}), result, "several outputs are returned as a tuple")
+
+	_, funcErr = acctest.CallFunction(t, "jq", document, tftypes.NewValue(tftypes.String, ".repository.name |"))
+	require.NotNil(t, funcErr)
+	assert.Contains(t, funcErr.Text, "invalid JQ expression")
+
+	_, funcErr = acctest.CallFunction(t, "jq", tftypes.NewValue(tftypes.String, "{"), tftypes.NewValue(tftypes.String, "."))
+	require.NotNil(t, funcErr)
+	assert.Contains(t, funcErr.Text, "invalid JSON")
+}
+
+func TestPortIdentifierFunction(t *testing.T) {
+	for name, identifier := range map[string]string{
+		"Payment Service (EU)": "payment-service-eu",
+		"  café_au_lait  ":     "cafe_au_lait",
+		"api.v2/Gateway":       "api-v2-gateway",
+	} {
+		result, funcErr := acctest.CallFunction(t, "identifier", tftypes.NewValue(tftypes.String, name))
+		require.Nil(t, funcErr)
+		assert.Equal(t, tftypes.NewValue(tftypes.String, identifier), result, name)
+	}
+
+	_, funcErr := acctest.CallFunction(t, "identifier", tftypes.NewValue(tftypes.String, "!!!"))
+	assert.NotNil(t, funcErr)
+}
+
+func TestPortSearchRuleFunction(t *testing.T) {
+	listType := tftypes.List{ElementType: tftypes.String}
+	result, funcErr := acctest.CallFunction(t, "search_rule",
+		tftypes.NewValue(tftypes.String, "language"),
+		tftypes.NewValue(tftypes.String, "in"),
+		tftypes.NewValue(listType, []tftypes.Value{
+			tftypes.NewValue(tftypes.String, "Go"),
+			tftypes.NewValue(tftypes.String, "Python"),
+		}),
+	)
+	require.Nil(t, funcErr)
+	assert.Equal(t, tftypes.NewValue(tftypes.String, `{"operator":"in","property":"language","value":["Go","Python"]}`), result)
+
+	result, funcErr = acctest.CallFunction(t, "search_rule",
+		tftypes.NewValue(tftypes.String, "replicas"),
+		tftypes.NewValue(tftypes.String, ">"),
+		tftypes.NewValue(tftypes.Number, 2),
+	)
+	require.Nil(t, funcErr)
+	assert.Equal(t, tftypes.NewValue(tftypes.String, `{"operator":">","property":"replicas","value":2}`), result)
+
+	result, funcErr = acctest.CallFunction(t, "search_rule",
+		tftypes.NewValue(tftypes.String, "owner"),
+		tftypes.NewValue(tftypes.String, "isEmpty"),
+		tftypes.NewValue(tftypes.DynamicPseudoType, nil),
+	)
+	require.Nil(t, funcErr)
+	assert.Equal(t, tftypes.NewValue(tftypes.String, `{"operator":"isEmpty","property":"owner"}`), result)
+}
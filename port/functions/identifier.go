@@ -0,0 +1,67 @@
+package functions
+
+import (
+	"context"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"golang.org/x/text/unicode/norm"
+)
+
+var _ function.Function = &IdentifierFunction{}
+
+func NewIdentifierFunction() function.Function {
+	return &IdentifierFunction{}
+}
+
+// IdentifierFunction turns names, like the title of a blueprint or the name of a repository, into identifiers.
+type IdentifierFunction struct{}
+
+func (f *IdentifierFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "identifier"
+}
+
+func (f *IdentifierFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Turns a name into an identifier",
+		MarkdownDescription: "Turns a name into an identifier that's valid for every kind of Port object: accents are removed, " +
+			"letters are lowercased, and every run of other characters than letters, digits and `_` becomes a `-`. " +
+			"For example `Payment Service (EU)` becomes `payment-service-eu`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "name",
+				MarkdownDescription: "The name to turn into an identifier",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *IdentifierFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var name string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &name))
+	if resp.Error != nil {
+		return
+	}
+
+	identifier := toIdentifier(name)
+	if identifier == "" {
+		resp.Error = function.NewArgumentFuncError(0, "the name has no letters or digits to make an identifier of")
+		return
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, identifier))
+}
+
+var identifierSeparators = regexp.MustCompile(`[^a-z0-9_]+`)
+
+func toIdentifier(name string) string {
+	var b strings.Builder
+	for _, r := range norm.NFKD.String(name) {
+		if !unicode.Is(unicode.Mn, r) {
+			b.WriteRune(unicode.ToLower(r))
+		}
+	}
+	return strings.Trim(identifierSeparators.ReplaceAllString(b.String(), "-"), "-")
+}
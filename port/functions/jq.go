@@ -0,0 +1,75 @@
+package functions
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/flex"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/jq"
+)
+
+var _ function.Function = &JqFunction{}
+
+func NewJqFunction() function.Function {
+	return &JqFunction{}
+}
+
+// JqFunction evaluates JQ expressions locally, so that mappings can be tried out in `terraform console` before Port
+// evaluates them.
+type JqFunction struct{}
+
+func (f *JqFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "jq"
+}
+
+func (f *JqFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Evaluates a JQ expression",
+		MarkdownDescription: "Evaluates a JQ expression on a JSON document, like Port evaluates the JQ of mappings and actions. " +
+			"Returns the output of the expression, or a tuple of its outputs when it has zero or several of them.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "json",
+				MarkdownDescription: "The JSON document to evaluate the expression on, e.g. the result of `jsonencode`",
+			},
+			function.StringParameter{
+				Name:                "expression",
+				MarkdownDescription: "The JQ expression",
+			},
+		},
+		Return: function.DynamicReturn{},
+	}
+}
+
+func (f *JqFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var document, expression string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &document, &expression))
+	if resp.Error != nil {
+		return
+	}
+
+	var input any
+	if err := json.Unmarshal([]byte(document), &input); err != nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("invalid JSON: %s", err))
+		return
+	}
+
+	outputs, err := jq.Evaluate(ctx, expression, input)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, err.Error())
+		return
+	}
+
+	var output any = outputs
+	if len(outputs) == 1 {
+		output = outputs[0]
+	}
+	result, err := flex.GoValueToFrameworkDynamic(output)
+	if err != nil {
+		resp.Error = function.NewFuncError(fmt.Sprintf("failed to convert the output of the expression: %s", err))
+		return
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
@@ -0,0 +1,80 @@
+package functions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/flex"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/utils"
+)
+
+var _ function.Function = &SearchRuleFunction{}
+
+func NewSearchRuleFunction() function.Function {
+	return &SearchRuleFunction{}
+}
+
+// SearchRuleFunction builds the JSON of a search rule, as the queries of `port_search` and the conditions of scorecard
+// filters take them.
+type SearchRuleFunction struct{}
+
+func (f *SearchRuleFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "search_rule"
+}
+
+func (f *SearchRuleFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Builds a search rule",
+		MarkdownDescription: "Builds the JSON of a search rule on a property, e.g. for the `rules` of the `query` of `port_search` " +
+			"or the `conditions` of a `port_scorecard` filter. " +
+			"For example `provider::port::search_rule(\"language\", \"in\", [\"Go\", \"Python\"])` returns " +
+			"`{\"operator\":\"in\",\"property\":\"language\",\"value\":[\"Go\",\"Python\"]}`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "property",
+				MarkdownDescription: "The property to match, or a meta property like `$identifier` or `$blueprint`",
+			},
+			function.StringParameter{
+				Name:                "operator",
+				MarkdownDescription: "The operator of the rule, e.g. `=`, `in` or `isEmpty`",
+			},
+			function.DynamicParameter{
+				Name:                "value",
+				MarkdownDescription: "The value to compare the property to, or `null` for operators that take no value, like `isEmpty`",
+				AllowNullValue:      true,
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *SearchRuleFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var property, operator string
+	var value types.Dynamic
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &property, &operator, &value))
+	if resp.Error != nil {
+		return
+	}
+
+	rule := map[string]any{
+		"property": property,
+		"operator": operator,
+	}
+	if !value.IsNull() && !value.IsUnderlyingValueNull() {
+		v, err := flex.FrameworkDynamicToGoValue(ctx, value)
+		if err != nil {
+			resp.Error = function.NewArgumentFuncError(2, err.Error())
+			return
+		}
+		rule["value"] = v
+	}
+
+	encoded, err := utils.GoObjectToTerraformString(rule, false)
+	if err != nil {
+		resp.Error = function.NewFuncError(fmt.Sprintf("failed to encode the rule: %s", err))
+		return
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, encoded))
+}
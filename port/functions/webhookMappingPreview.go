@@ -0,0 +1,238 @@
+package functions
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/flex"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/jq"
+)
+
+var _ function.Function = &WebhookMappingPreviewFunction{}
+
+func NewWebhookMappingPreviewFunction() function.Function {
+	return &WebhookMappingPreviewFunction{}
+}
+
+// WebhookMappingPreviewFunction evaluates the mappings of a webhook on a sample event, the way Port does when the
+// event arrives, so that mapping bugs show up before the webhook is deployed.
+type WebhookMappingPreviewFunction struct{}
+
+func (f *WebhookMappingPreviewFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "webhook_mapping_preview"
+}
+
+func (f *WebhookMappingPreviewFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Previews the entities the mappings of a webhook produce",
+		MarkdownDescription: "Evaluates the `mappings` of a `port_webhook` on a sample event, and returns the entities they produce. " +
+			"Mappings whose `filter` isn't `true` are skipped, and mappings with `items_to_parse` produce an entity per item. " +
+			"Each entity has the `blueprint`, `operation`, `identifier`, `title`, `icon`, `team`, `properties` and `relations` " +
+			"the mapping sets.",
+		Parameters: []function.Parameter{
+			function.DynamicParameter{
+				Name:                "mappings",
+				MarkdownDescription: "The mappings of the webhook, e.g. `port_webhook.github.mappings`",
+			},
+			function.StringParameter{
+				Name:                "event",
+				MarkdownDescription: "The sample event in JSON format, an object with the `body`, `headers` and `queryParams` of the request",
+			},
+		},
+		Return: function.DynamicReturn{},
+	}
+}
+
+func (f *WebhookMappingPreviewFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var mappingsValue types.Dynamic
+	var eventJSON string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &mappingsValue, &eventJSON))
+	if resp.Error != nil {
+		return
+	}
+
+	decodedMappings, err := flex.FrameworkDynamicToGoValue(ctx, mappingsValue)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+	mappings, ok := decodedMappings.([]any)
+	if !ok {
+		resp.Error = function.NewArgumentFuncError(0, "the mappings must be a list of mapping objects")
+		return
+	}
+
+	var event map[string]any
+	if err := json.Unmarshal([]byte(eventJSON), &event); err != nil {
+		resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("the event must be a JSON object: %s", err))
+		return
+	}
+
+	entities := []any{}
+	for i, m := range mappings {
+		mapping, ok := m.(map[string]any)
+		if !ok {
+			resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("mappings[%d] must be an object", i))
+			return
+		}
+		mappingEntities, err := previewMapping(ctx, mapping, event)
+		if err != nil {
+			resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("mappings[%d].%s", i, err))
+			return
+		}
+		entities = append(entities, mappingEntities...)
+	}
+
+	result, err := flex.GoValueToFrameworkDynamic(entities)
+	if err != nil {
+		resp.Error = function.NewFuncError(fmt.Sprintf("failed to convert the entities: %s", err))
+		return
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// previewMapping returns the entities a mapping produces for the event. Errors start with the path of the attribute
+// whose expression failed, relative to the mapping.
+func previewMapping(ctx context.Context, mapping map[string]any, event map[string]any) ([]any, error) {
+	if filter, ok := mapping["filter"].(string); ok {
+		matches, err := first(ctx, filter, event)
+		if err != nil {
+			return nil, fmt.Errorf("filter: %s", err)
+		}
+		if matches != true {
+			return nil, nil
+		}
+	}
+
+	inputs := []any{event}
+	if itemsToParse, ok := mapping["items_to_parse"].(string); ok {
+		items, err := first(ctx, itemsToParse, event)
+		if err != nil {
+			return nil, fmt.Errorf("items_to_parse: %s", err)
+		}
+		list, ok := items.([]any)
+		if !ok {
+			return nil, fmt.Errorf("items_to_parse: the expression must return an array, got %v", items)
+		}
+		inputs = make([]any, 0, len(list))
+		for _, item := range list {
+			input := maps.Clone(event)
+			input["item"] = item
+			inputs = append(inputs, input)
+		}
+	}
+
+	operation := "create"
+	if o, ok := mapping["operation"].(map[string]any); ok {
+		if t, ok := o["type"].(string); ok {
+			operation = t
+		}
+	}
+	entityMapping, _ := mapping["entity"].(map[string]any)
+
+	entities := make([]any, 0, len(inputs))
+	for _, input := range inputs {
+		entity := map[string]any{
+			"blueprint": mapping["blueprint"],
+			"operation": operation,
+		}
+
+		for _, field := range []string{"identifier", "title", "icon", "team"} {
+			expression, ok := entityMapping[field].(string)
+			if !ok {
+				continue
+			}
+			var value any
+			var err error
+			if field == "identifier" {
+				value, err = evaluateMappingValue(ctx, expression, input)
+			} else {
+				value, err = first(ctx, expression, input)
+			}
+			if err != nil {
+				return nil, fmt.Errorf("entity.%s: %s", field, err)
+			}
+			entity[field] = value
+		}
+
+		for _, field := range []string{"properties", "relations"} {
+			values := map[string]any{}
+			expressions, _ := entityMapping[field].(map[string]any)
+			for _, key := range slices.Sorted(maps.Keys(expressions)) {
+				expression, ok := expressions[key].(string)
+				if !ok {
+					continue
+				}
+				var value any
+				var err error
+				if field == "relations" {
+					value, err = evaluateMappingValue(ctx, expression, input)
+				} else {
+					value, err = first(ctx, expression, input)
+				}
+				if err != nil {
+					return nil, fmt.Errorf("entity.%s.%s: %s", field, key, err)
+				}
+				values[key] = value
+			}
+			entity[field] = values
+		}
+
+		entities = append(entities, entity)
+	}
+	return entities, nil
+}
+
+// evaluateMappingValue evaluates an identifier or a relation, which is either a JQ expression or a JSON encoded search
+// query whose strings are JQ expressions.
+func evaluateMappingValue(ctx context.Context, value string, input any) (any, error) {
+	var query map[string]any
+	if err := json.Unmarshal([]byte(value), &query); err != nil {
+		return first(ctx, value, input)
+	}
+	return evaluateStrings(ctx, query, input)
+}
+
+func evaluateStrings(ctx context.Context, value any, input any) (any, error) {
+	switch value := value.(type) {
+	case string:
+		return first(ctx, value, input)
+	case []any:
+		evaluated := make([]any, 0, len(value))
+		for _, item := range value {
+			v, err := evaluateStrings(ctx, item, input)
+			if err != nil {
+				return nil, err
+			}
+			evaluated = append(evaluated, v)
+		}
+		return evaluated, nil
+	case map[string]any:
+		evaluated := make(map[string]any, len(value))
+		for _, key := range slices.Sorted(maps.Keys(value)) {
+			v, err := evaluateStrings(ctx, value[key], input)
+			if err != nil {
+				return nil, err
+			}
+			evaluated[key] = v
+		}
+		return evaluated, nil
+	default:
+		return value, nil
+	}
+}
+
+// first returns the first output of the expression, like Port keeps when it evaluates mappings, or null when it has
+// none.
+func first(ctx context.Context, expression string, input any) (any, error) {
+	outputs, err := jq.Evaluate(ctx, expression, input)
+	if err != nil || len(outputs) == 0 {
+		return nil, err
+	}
+	return outputs[0], nil
+}
@@ -0,0 +1,108 @@
+package functions_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/acctest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func webhookMapping(attributes map[string]tftypes.Value) tftypes.Value {
+	types := map[string]tftypes.Type{}
+	for name, value := range attributes {
+		types[name] = value.Type()
+	}
+	return tftypes.NewValue(tftypes.Object{AttributeTypes: types}, attributes)
+}
+
+func stringMap(values map[string]string) tftypes.Value {
+	attributes := map[string]tftypes.Value{}
+	for key, value := range values {
+		attributes[key] = tftypes.NewValue(tftypes.String, value)
+	}
+	return webhookMapping(attributes)
+}
+
+func TestPortWebhookMappingPreviewFunction(t *testing.T) {
+	pullRequests := webhookMapping(map[string]tftypes.Value{
+		"blueprint": tftypes.NewValue(tftypes.String, "pull_request"),
+		"filter":    tftypes.NewValue(tftypes.String, `.headers."x-github-event" == "pull_request"`),
+		"entity": webhookMapping(map[string]tftypes.Value{
+			"identifier": tftypes.NewValue(tftypes.String, ".body.pull_request.id | tostring"),
+			"title":      tftypes.NewValue(tftypes.String, ".body.pull_request.title"),
+			"properties": stringMap(map[string]string{"url": ".body.pull_request.html_url"}),
+			"relations": stringMap(map[string]string{
+				"author": `{"combinator":"'and'","rules":[{"property":"'$identifier'","operator":"'='","value":".body.pull_request.user.login"}]}`,
+			}),
+		}),
+	})
+	labels := webhookMapping(map[string]tftypes.Value{
+		"blueprint":      tftypes.NewValue(tftypes.String, "label"),
+		"items_to_parse": tftypes.NewValue(tftypes.String, ".body.pull_request.labels"),
+		"operation":      webhookMapping(map[string]tftypes.Value{"type": tftypes.NewValue(tftypes.String, "create")}),
+		"entity": webhookMapping(map[string]tftypes.Value{
+			"identifier": tftypes.NewValue(tftypes.String, ".item.name"),
+		}),
+	})
+	pushes := webhookMapping(map[string]tftypes.Value{
+		"blueprint": tftypes.NewValue(tftypes.String, "commit"),
+		"filter":    tftypes.NewValue(tftypes.String, `.headers."x-github-event" == "push"`),
+		"entity": webhookMapping(map[string]tftypes.Value{
+			"identifier": tftypes.NewValue(tftypes.String, ".body.after"),
+		}),
+	})
+	mappings := tftypes.NewValue(tftypes.Tuple{ElementTypes: []tftypes.Type{pullRequests.Type(), labels.Type(), pushes.Type()}},
+		[]tftypes.Value{pullRequests, labels, pushes})
+	event := tftypes.NewValue(tftypes.String, `{
+		"headers": {"x-github-event": "pull_request"},
+		"body": {"pull_request": {
+			"id": 42, "title": "Fix the build", "html_url": "https://github.com/port-labs/api/pull/42",
+			"user": {"login": "octocat"}, "labels": [{"name": "bug"}, {"name": "ci"}]
+		}}
+	}`)
+
+	_, funcErr := acctest.CallFunction(t, "webhook_mapping_preview", tftypes.NewValue(tftypes.DynamicPseudoType, nil), event)
+	require.NotNil(t, funcErr, "the mappings are required")
+
+	result, funcErr := acctest.CallFunction(t, "webhook_mapping_preview", mappings, event)
+	require.Nil(t, funcErr)
+	var entities []tftypes.Value
+	require.NoError(t, result.As(&entities))
+	require.Len(t, entities, 3, "the push mapping is filtered out and each label is an entity")
+
+	var pullRequest map[string]tftypes.Value
+	require.NoError(t, entities[0].As(&pullRequest))
+	assert.Equal(t, tftypes.NewValue(tftypes.String, "pull_request"), pullRequest["blueprint"])
+	assert.Equal(t, tftypes.NewValue(tftypes.String, "create"), pullRequest["operation"])
+	assert.Equal(t, tftypes.NewValue(tftypes.String, "42"), pullRequest["identifier"])
+	assert.Equal(t, tftypes.NewValue(tftypes.String, "Fix the build"), pullRequest["title"])
+	var properties map[string]tftypes.Value
+	require.NoError(t, pullRequest["properties"].As(&properties))
+	assert.Equal(t, tftypes.NewValue(tftypes.String, "https://github.com/port-labs/api/pull/42"), properties["url"])
+	var relations map[string]tftypes.Value
+	require.NoError(t, pullRequest["relations"].As(&relations))
+	var author map[string]tftypes.Value
+	require.NoError(t, relations["author"].As(&author))
+	assert.Equal(t, tftypes.NewValue(tftypes.String, "and"), author["combinator"])
+
+	for i, name := range []string{"bug", "ci"} {
+		var label map[string]tftypes.Value
+		require.NoError(t, entities[i+1].As(&label))
+		assert.Equal(t, tftypes.NewValue(tftypes.String, "label"), label["blueprint"])
+		assert.Equal(t, tftypes.NewValue(tftypes.String, name), label["identifier"])
+	}
+
+	broken := webhookMapping(map[string]tftypes.Value{
+		"blueprint": tftypes.NewValue(tftypes.String, "pull_request"),
+		"entity": webhookMapping(map[string]tftypes.Value{
+			"identifier": tftypes.NewValue(tftypes.String, ".body.pull_request.id"),
+			"properties": stringMap(map[string]string{"size": ".body.pull_request.title | length + \"lines\""}),
+		}),
+	})
+	_, funcErr = acctest.CallFunction(t, "webhook_mapping_preview",
+		tftypes.NewValue(tftypes.Tuple{ElementTypes: []tftypes.Type{broken.Type()}}, []tftypes.Value{broken}), event)
+	require.NotNil(t, funcErr)
+	assert.Contains(t, funcErr.Text, "mappings[0].entity.properties.size: ")
+}
@@ -0,0 +1,134 @@
+package integration_resync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/consts"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/poll"
+)
+
+var _ action.Action = &IntegrationResyncAction{}
+var _ action.ActionWithConfigure = &IntegrationResyncAction{}
+
+const defaultWaitTimeout = 30 * time.Minute
+
+func NewIntegrationResyncAction() action.Action {
+	return &IntegrationResyncAction{}
+}
+
+type IntegrationResyncAction struct {
+	portClient *cli.PortClient
+}
+
+func (a *IntegrationResyncAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_integration_resync"
+}
+
+func (a *IntegrationResyncAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	a.portClient = req.ProviderData.(*cli.PortClient)
+}
+
+func (a *IntegrationResyncAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data IntegrationResyncModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	installationID := data.InstallationId.ValueString()
+	integration, err := a.portClient.GetIntegration(ctx, installationID)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("failed to read integration %s", installationID), err.Error())
+		return
+	}
+	// The integration keeps reporting its previous resync until it picks up the new one, which is told apart by
+	// its start time.
+	previousStart := resyncStart(integration.ResyncState)
+
+	err = a.portClient.ResyncIntegration(ctx, installationID)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("failed to resync integration %s", installationID), err.Error())
+		return
+	}
+	resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Started a resync of integration %s", installationID)})
+
+	if !data.WaitForCompletion.ValueBool() {
+		return
+	}
+
+	timeout := defaultWaitTimeout
+	if !data.WaitTimeoutSeconds.IsNull() {
+		timeout = time.Duration(data.WaitTimeoutSeconds.ValueInt64()) * time.Second
+	}
+	waitCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var state *cli.ResyncState
+	status := ""
+	err = poll.Until(waitCtx, func(ctx context.Context) (bool, error) {
+		integration, err := a.portClient.GetIntegration(ctx, installationID)
+		if err != nil {
+			return false, err
+		}
+		state = integration.ResyncState
+		start := resyncStart(state)
+		if start == nil || (previousStart != nil && !start.After(*previousStart)) {
+			return false, nil
+		}
+		if state.Status != status {
+			resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("The resync of integration %s is %s", installationID, state.Status)})
+		}
+		status = state.Status
+		return status != consts.ResyncRunning, nil
+	})
+	if errors.Is(err, poll.TimeoutError) {
+		resp.Diagnostics.AddError(fmt.Sprintf("timed out waiting for the resync of integration %s", installationID),
+			fmt.Sprintf("The resync didn't finish within %s", timeout))
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("failed to read integration %s", installationID), err.Error())
+		return
+	}
+
+	if state.Metrics != nil {
+		resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("The resync of integration %s upserted %d entities, deleted %d and failed to sync %d",
+			installationID, state.Metrics.Upserted, state.Metrics.Deleted, state.Metrics.Failed)})
+	}
+
+	if status != consts.ResyncCompleted {
+		resp.Diagnostics.AddError(fmt.Sprintf("the resync of integration %s didn't complete", installationID),
+			fmt.Sprintf("The resync finished with status %s%s", status, resyncErrors(state)))
+		return
+	}
+	if state.Metrics != nil && state.Metrics.Failed > 0 {
+		resp.Diagnostics.AddWarning(fmt.Sprintf("the resync of integration %s failed to sync %d entities", installationID, state.Metrics.Failed),
+			strings.TrimPrefix(resyncErrors(state), "\n\n"))
+	}
+}
+
+func resyncStart(state *cli.ResyncState) *time.Time {
+	if state == nil {
+		return nil
+	}
+	return state.LastResyncStart
+}
+
+// resyncErrors lists the errors the integration reported for its resync, as a paragraph of a diagnostic detail.
+func resyncErrors(state *cli.ResyncState) string {
+	if state.Metrics == nil || len(state.Metrics.Errors) == 0 {
+		return ""
+	}
+	return "\n\n" + strings.Join(state.Metrics.Errors, "\n")
+}
@@ -0,0 +1,126 @@
+package integration_resync_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/acctest"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/fakeport"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/poll"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPortIntegrationResync(t *testing.T) {
+	server := fakeport.NewServer()
+	defer server.Close()
+	server.PutObject("integration", "github-ocean", map[string]any{"installationId": "github-ocean", "title": "GitHub"})
+
+	progress, diagnostics := acctest.Invoke(t, server, "port_integration_resync", map[string]tftypes.Value{
+		"installation_id": tftypes.NewValue(tftypes.String, "github-ocean"),
+	})
+	require.Empty(t, diagnostics)
+	assert.Equal(t, []string{"Started a resync of integration github-ocean"}, progress)
+
+	integration, ok := server.Object("integration", "github-ocean")
+	require.True(t, ok)
+	assert.NotNil(t, integration["resyncState"])
+}
+
+func TestPortIntegrationResyncWaitForCompletion(t *testing.T) {
+	server := fakeport.NewServer()
+	defer server.Close()
+	server.PutObject("integration", "github-ocean", map[string]any{
+		"installationId": "github-ocean",
+		"title":          "GitHub",
+		"resyncState":    map[string]any{"status": "completed", "lastResyncStart": "2025-01-01T00:00:00Z"},
+	})
+	config := map[string]tftypes.Value{
+		"installation_id":     tftypes.NewValue(tftypes.String, "github-ocean"),
+		"wait_for_completion": tftypes.NewValue(tftypes.Bool, true),
+	}
+
+	server.SetResyncState(map[string]any{
+		"status":  "completed",
+		"metrics": map[string]any{"upserted": 12, "deleted": 1, "failed": 0},
+	})
+	progress, diagnostics := acctest.Invoke(t, server, "port_integration_resync", config)
+	assert.Empty(t, diagnostics)
+	assert.Equal(t, []string{
+		"Started a resync of integration github-ocean",
+		"The resync of integration github-ocean is completed",
+		"The resync of integration github-ocean upserted 12 entities, deleted 1 and failed to sync 0",
+	}, progress)
+
+	server.SetResyncState(map[string]any{
+		"status":  "completed",
+		"metrics": map[string]any{"upserted": 10, "deleted": 0, "failed": 2, "errors": []any{"repository: missing identifier"}},
+	})
+	_, diagnostics = acctest.Invoke(t, server, "port_integration_resync", config)
+	require.Len(t, diagnostics, 1)
+	assert.Equal(t, tfprotov6.DiagnosticSeverityWarning, diagnostics[0].Severity)
+	assert.Equal(t, "the resync of integration github-ocean failed to sync 2 entities", diagnostics[0].Summary)
+	assert.Equal(t, "repository: missing identifier", diagnostics[0].Detail)
+
+	server.SetResyncState(map[string]any{"status": "failed"})
+	_, diagnostics = acctest.Invoke(t, server, "port_integration_resync", config)
+	require.Len(t, diagnostics, 1)
+	assert.Equal(t, tfprotov6.DiagnosticSeverityError, diagnostics[0].Severity)
+	assert.Equal(t, "the resync of integration github-ocean didn't complete", diagnostics[0].Summary)
+	assert.Equal(t, "The resync finished with status failed", diagnostics[0].Detail)
+}
+
+func TestPortIntegrationResyncPollsUntilFinished(t *testing.T) {
+	defer poll.SetInterval(10 * time.Millisecond)()
+	server := fakeport.NewServer()
+	defer server.Close()
+	server.PutObject("integration", "github-ocean", map[string]any{"installationId": "github-ocean", "title": "GitHub"})
+
+	// The resync is running until the integration is read for the fourth time, the first read being the one before
+	// the resync is started.
+	server.SetResyncState(map[string]any{"status": "running"})
+	reads := 0
+	server.OnRead("integration", func(integration map[string]any) {
+		reads++
+		if reads == 4 {
+			integration["resyncState"].(map[string]any)["status"] = "completed"
+		}
+	})
+	progress, diagnostics := acctest.Invoke(t, server, "port_integration_resync", map[string]tftypes.Value{
+		"installation_id":     tftypes.NewValue(tftypes.String, "github-ocean"),
+		"wait_for_completion": tftypes.NewValue(tftypes.Bool, true),
+	})
+	assert.Empty(t, diagnostics)
+	assert.Equal(t, 4, reads)
+	assert.Equal(t, []string{
+		"Started a resync of integration github-ocean",
+		"The resync of integration github-ocean is running",
+		"The resync of integration github-ocean is completed",
+	}, progress)
+}
+
+func TestPortIntegrationResyncTimeout(t *testing.T) {
+	defer poll.SetInterval(100 * time.Millisecond)()
+	server := fakeport.NewServer()
+	defer server.Close()
+	server.PutObject("integration", "github-ocean", map[string]any{"installationId": "github-ocean", "title": "GitHub"})
+
+	server.SetResyncState(map[string]any{"status": "running"})
+	start := time.Now()
+	progress, diagnostics := acctest.Invoke(t, server, "port_integration_resync", map[string]tftypes.Value{
+		"installation_id":      tftypes.NewValue(tftypes.String, "github-ocean"),
+		"wait_for_completion":  tftypes.NewValue(tftypes.Bool, true),
+		"wait_timeout_seconds": tftypes.NewValue(tftypes.Number, 1),
+	})
+	assert.Less(t, time.Since(start), 5*time.Second)
+	assert.Equal(t, []string{
+		"Started a resync of integration github-ocean",
+		"The resync of integration github-ocean is running",
+	}, progress)
+	require.Len(t, diagnostics, 1)
+	assert.Equal(t, tfprotov6.DiagnosticSeverityError, diagnostics[0].Severity)
+	assert.Equal(t, "timed out waiting for the resync of integration github-ocean", diagnostics[0].Summary)
+	assert.Equal(t, "The resync didn't finish within 1s", diagnostics[0].Detail)
+}
@@ -0,0 +1,9 @@
+package integration_resync
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+type IntegrationResyncModel struct {
+	InstallationId     types.String `tfsdk:"installation_id"`
+	WaitForCompletion  types.Bool   `tfsdk:"wait_for_completion"`
+	WaitTimeoutSeconds types.Int64  `tfsdk:"wait_timeout_seconds"`
+}
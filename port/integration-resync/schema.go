@@ -0,0 +1,68 @@
+package integration_resync
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+func IntegrationResyncSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"installation_id": schema.StringAttribute{
+			MarkdownDescription: "The installation ID of the integration to resync",
+			Required:            true,
+		},
+		"wait_for_completion": schema.BoolAttribute{
+			MarkdownDescription: "Wait until the resync finishes, and fail when it doesn't complete. Defaults to `false`",
+			Optional:            true,
+		},
+		"wait_timeout_seconds": schema.Int64Attribute{
+			MarkdownDescription: "The amount of seconds to wait for the resync to finish, when `wait_for_completion` is set. Defaults to `1800`",
+			Optional:            true,
+			Validators: []validator.Int64{
+				int64validator.AtLeast(1),
+			},
+		},
+	}
+}
+
+func (a *IntegrationResyncAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: IntegrationResyncMarkdownDescription,
+		Attributes:          IntegrationResyncSchema(),
+	}
+}
+
+var IntegrationResyncMarkdownDescription = `
+
+# Integration Resync
+
+Resyncs an integration, so that changes to its mapping apply without resyncing it in the UI.
+
+Requires Terraform 1.14 or later.
+
+## Example Usage
+
+` + "```hcl" + `
+action "port_integration_resync" "github" {
+  config {
+    installation_id     = port_integration.github.installation_id
+    wait_for_completion = true
+  }
+}
+
+resource "port_integration" "github" {
+  # ...
+
+  lifecycle {
+    action_trigger {
+      events  = [after_update]
+      actions = [action.port_integration_resync.github]
+    }
+  }
+}
+` + "```" + `
+`
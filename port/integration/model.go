@@ -1,6 +1,9 @@
 package integration
 
-import "github.com/hashicorp/terraform-plugin-framework/types"
+import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
 
 type WebhookChangelogDestinationModel struct {
 	Url   types.String `tfsdk:"url"`
@@ -16,4 +19,5 @@ type IntegrationModel struct {
 	Config                      types.String                      `tfsdk:"config"`
 	KafkaChangelogDestination   types.Object                      `tfsdk:"kafka_changelog_destination"`
 	WebhookChangelogDestination *WebhookChangelogDestinationModel `tfsdk:"webhook_changelog_destination"`
+	Timeouts                    timeouts.Value                    `tfsdk:"timeouts"`
 }
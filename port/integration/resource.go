@@ -6,6 +6,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/consts"
 )
 
 var _ resource.Resource = &IntegrationResource{}
@@ -77,6 +78,14 @@ func (r *IntegrationResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
+	updateTimeout, diags := state.Timeouts.Update(ctx, consts.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	integrationIdentifier := state.InstallationId.ValueString()
 
 	integration, err := integrationToPortBody(state)
@@ -106,6 +115,18 @@ func (r *IntegrationResource) Delete(ctx context.Context, req resource.DeleteReq
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, consts.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	integrationIdentifier := state.InstallationId.ValueString()
 
 	_, err := r.portClient.DeleteIntegration(ctx, integrationIdentifier)
@@ -130,6 +151,14 @@ func (r *IntegrationResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
+	createTimeout, diags := state.Timeouts.Create(ctx, consts.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	integration, err := integrationToPortBody(state)
 	if err != nil {
 		resp.Diagnostics.AddError("failed to convert integration to port body", err.Error())
@@ -9,7 +9,6 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/port-labs/terraform-provider-port-labs/v2/internal/acctest"
-	"github.com/port-labs/terraform-provider-port-labs/v2/internal/utils"
 )
 
 func createIntegration(
@@ -89,7 +88,7 @@ func createIntegrationWithWebHook(
 }
 
 func TestPortIntegrationBasic(t *testing.T) {
-	integrationIdentifier := utils.GenID()
+	integrationIdentifier := acctest.GenID(t)
 	installationAppType := "kafka"
 	err := os.Setenv("PORT_BETA_FEATURES_ENABLED", "true")
 	if err != nil {
@@ -101,7 +100,7 @@ func TestPortIntegrationBasic(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: testPortIntegrationResourceBasic,
@@ -127,7 +126,7 @@ func TestPortIntegrationBasic(t *testing.T) {
 }
 
 func TestPortIntegrationPatchTitleNull(t *testing.T) {
-	integrationIdentifier := utils.GenID()
+	integrationIdentifier := acctest.GenID(t)
 	installationAppType := "kafka"
 	err := os.Setenv("PORT_BETA_FEATURES_ENABLED", "true")
 	if err != nil {
@@ -139,7 +138,7 @@ func TestPortIntegrationPatchTitleNull(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: testPortIntegrationResourceBasic,
@@ -166,7 +165,7 @@ func TestPortIntegrationPatchTitleNull(t *testing.T) {
 }
 
 func TestPortIntegrationWithWebhook(t *testing.T) {
-	integrationIdentifier := utils.GenID()
+	integrationIdentifier := acctest.GenID(t)
 	err := os.Setenv("PORT_BETA_FEATURES_ENABLED", "true")
 	if err != nil {
 		t.Fatal(err)
@@ -175,7 +174,7 @@ func TestPortIntegrationWithWebhook(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: testPortIntegrationResourceBasic,
@@ -194,11 +193,11 @@ func TestPortIntegrationWithWebhook(t *testing.T) {
 }
 
 func TestPortIntegrationImport(t *testing.T) {
-	integrationIdentifier := utils.GenID()
+	integrationIdentifier := acctest.GenID(t)
 	var testPortIntegrationResourceBasic = createIntegrationWithWebHook(integrationIdentifier, "kafka")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: acctest.ProviderConfig + testPortIntegrationResourceBasic,
@@ -257,7 +256,7 @@ func TestPortIntegrationInvalidIdentifier(t *testing.T) {
 			config := createIntegration(tc.identifier, installationAppType)
 			resource.Test(t, resource.TestCase{
 				PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-				ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+				ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 				Steps: []resource.TestStep{
 					{
 						Config:      config,
@@ -288,11 +287,11 @@ func TestPortIntegrationValidIdentifier(t *testing.T) {
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			installationID := tc.buildID(utils.GenID())
+			installationID := tc.buildID(acctest.GenID(t))
 			config := createIntegration(installationID, installationAppType)
 			resource.Test(t, resource.TestCase{
 				PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-				ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+				ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 				Steps: []resource.TestStep{
 					{
 						Config: config,
@@ -0,0 +1,80 @@
+package migration
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
+)
+
+var _ datasource.DataSource = &MigrationDataSource{}
+
+func NewMigrationDataSource() datasource.DataSource {
+	return &MigrationDataSource{}
+}
+
+type MigrationDataSource struct {
+	portClient *cli.PortClient
+}
+
+func (d *MigrationDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.portClient = req.ProviderData.(*cli.PortClient)
+}
+
+func (d *MigrationDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_migration"
+}
+
+func (d *MigrationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data MigrationDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	m, err := d.portClient.GetMigration(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("failed to read migration", err.Error())
+		return
+	}
+
+	refreshMigrationState(&data, m)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func refreshMigrationState(state *MigrationDataSourceModel, m *cli.Migration) {
+	state.ID = types.StringValue(m.Id)
+	state.Status = types.StringValue(m.Status)
+	state.Actor = types.StringValue(m.Actor)
+	state.SourceBlueprint = types.StringValue(m.SourceBlueprint)
+	state.DeleteBlueprint = types.BoolValue(m.DeleteBlueprint)
+	state.DeleteEntities = types.BoolValue(m.DeleteEntities)
+
+	// The entity counts are only returned once the migration starts processing entities.
+	state.SuccessCount = types.Int64Null()
+	if m.SuccessCount != nil {
+		state.SuccessCount = types.Int64Value(int64(*m.SuccessCount))
+	}
+	state.FailureCount = types.Int64Null()
+	if m.FailureCount != nil {
+		state.FailureCount = types.Int64Value(int64(*m.FailureCount))
+	}
+
+	state.CreatedAt = types.StringNull()
+	if m.CreatedAt != nil {
+		state.CreatedAt = types.StringValue(m.CreatedAt.String())
+	}
+	state.CreatedBy = types.StringValue(m.CreatedBy)
+	state.UpdatedAt = types.StringNull()
+	if m.UpdatedAt != nil {
+		state.UpdatedAt = types.StringValue(m.UpdatedAt.String())
+	}
+	state.UpdatedBy = types.StringValue(m.UpdatedBy)
+}
@@ -0,0 +1,90 @@
+package migration
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+)
+
+func MigrationDataSourceSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "The identifier of the migration",
+			Required:            true,
+		},
+		"status": schema.StringAttribute{
+			MarkdownDescription: "The status of the migration, e.g. `RUNNING`, `COMPLETED`, `FAILURE` or `CANCELLED`",
+			Computed:            true,
+		},
+		"actor": schema.StringAttribute{
+			MarkdownDescription: "The user or client that started the migration",
+			Computed:            true,
+		},
+		"source_blueprint": schema.StringAttribute{
+			MarkdownDescription: "The identifier of the blueprint the migration runs on",
+			Computed:            true,
+		},
+		"delete_blueprint": schema.BoolAttribute{
+			MarkdownDescription: "Whether the migration deletes the blueprint once its entities are deleted",
+			Computed:            true,
+		},
+		"delete_entities": schema.BoolAttribute{
+			MarkdownDescription: "Whether the migration deletes the entities of the blueprint",
+			Computed:            true,
+		},
+		"success_count": schema.Int64Attribute{
+			MarkdownDescription: "The number of entities the migration processed successfully, if Port reports it",
+			Computed:            true,
+		},
+		"failure_count": schema.Int64Attribute{
+			MarkdownDescription: "The number of entities the migration failed to process, if Port reports it",
+			Computed:            true,
+		},
+		"created_at": schema.StringAttribute{
+			MarkdownDescription: "The creation date of the migration",
+			Computed:            true,
+		},
+		"created_by": schema.StringAttribute{
+			MarkdownDescription: "The creator of the migration",
+			Computed:            true,
+		},
+		"updated_at": schema.StringAttribute{
+			MarkdownDescription: "The last update date of the migration",
+			Computed:            true,
+		},
+		"updated_by": schema.StringAttribute{
+			MarkdownDescription: "The last updater of the migration",
+			Computed:            true,
+		},
+	}
+}
+
+func (d *MigrationDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: MigrationDataSourceMarkdownDescription,
+		Attributes:          MigrationDataSourceSchema(),
+	}
+}
+
+var MigrationDataSourceMarkdownDescription = `
+
+# Migration Data Source
+
+The migration data source allows you to read a migration in Port by its identifier, e.g. the migration that deletes
+the entities of a blueprint with ` + "`force_delete_entities`" + `. The identifier of the migration is part of the
+diagnostics of a delete that failed, was cancelled or timed out, so pipelines can inspect its status and entity counts.
+
+## Example Usage
+
+` + "```hcl" + `
+
+data "port_migration" "delete_microservice" {
+  id = var.migration_id
+}
+
+output "migration_status" {
+  value = data.port_migration.delete_microservice.status
+}
+
+` + "```" + ``
@@ -0,0 +1,59 @@
+package migration_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/acctest"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/acctest/fakeclient"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func count(t *testing.T, value tftypes.Value) int64 {
+	t.Helper()
+	var n big.Float
+	require.NoError(t, value.As(&n))
+	i, _ := n.Int64()
+	return i
+}
+
+func TestPortMigrationDataSource(t *testing.T) {
+	ctx := context.Background()
+	server, client := fakeclient.New(t)
+	_, err := client.CreateBlueprint(ctx, &cli.Blueprint{
+		Identifier: "microservice",
+		Title:      "Microservice",
+		Schema:     cli.BlueprintSchema{Properties: map[string]cli.BlueprintProperty{}},
+	}, nil)
+	require.NoError(t, err)
+	_, err = client.CreateEntity(ctx, &cli.Entity{Identifier: "payments", Blueprint: "microservice"}, "", false)
+	require.NoError(t, err)
+
+	server.SetMigrationStatus("RUNNING")
+	migrationID, err := client.DeleteBlueprintWithAllEntities(ctx, "microservice")
+	require.NoError(t, err)
+
+	state, diags := acctest.ReadDataSource(t, server, "port_migration", map[string]tftypes.Value{
+		"id": tftypes.NewValue(tftypes.String, *migrationID),
+	})
+	require.Empty(t, diags)
+	assert.Equal(t, tftypes.NewValue(tftypes.String, *migrationID), state["id"])
+	assert.Equal(t, tftypes.NewValue(tftypes.String, "RUNNING"), state["status"])
+	assert.Equal(t, tftypes.NewValue(tftypes.String, "microservice"), state["source_blueprint"])
+	assert.Equal(t, tftypes.NewValue(tftypes.Bool, true), state["delete_entities"])
+	assert.Equal(t, int64(1), count(t, state["success_count"]))
+	assert.Equal(t, int64(0), count(t, state["failure_count"]))
+	assert.False(t, state["created_at"].IsNull())
+
+	_, diags = acctest.ReadDataSource(t, server, "port_migration", map[string]tftypes.Value{
+		"id": tftypes.NewValue(tftypes.String, "missing"),
+	})
+	require.Len(t, diags, 1)
+	assert.Equal(t, tfprotov6.DiagnosticSeverityError, diags[0].Severity)
+	assert.Equal(t, "failed to read migration", diags[0].Summary)
+}
@@ -0,0 +1,18 @@
+package migration
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+type MigrationDataSourceModel struct {
+	ID              types.String `tfsdk:"id"`
+	Status          types.String `tfsdk:"status"`
+	Actor           types.String `tfsdk:"actor"`
+	SourceBlueprint types.String `tfsdk:"source_blueprint"`
+	DeleteBlueprint types.Bool   `tfsdk:"delete_blueprint"`
+	DeleteEntities  types.Bool   `tfsdk:"delete_entities"`
+	SuccessCount    types.Int64  `tfsdk:"success_count"`
+	FailureCount    types.Int64  `tfsdk:"failure_count"`
+	CreatedAt       types.String `tfsdk:"created_at"`
+	CreatedBy       types.String `tfsdk:"created_by"`
+	UpdatedAt       types.String `tfsdk:"updated_at"`
+	UpdatedBy       types.String `tfsdk:"updated_by"`
+}
@@ -5,8 +5,10 @@ import (
 )
 
 type OrganizationSecretModel struct {
-	ID          types.String `tfsdk:"id"`
-	SecretName  types.String `tfsdk:"secret_name"`
-	SecretValue types.String `tfsdk:"secret_value"`
-	Description types.String `tfsdk:"description"`
+	ID                   types.String `tfsdk:"id"`
+	SecretName           types.String `tfsdk:"secret_name"`
+	SecretValue          types.String `tfsdk:"secret_value"`
+	SecretValueWo        types.String `tfsdk:"secret_value_wo"`
+	SecretValueWoVersion types.Int64  `tfsdk:"secret_value_wo_version"`
+	Description          types.String `tfsdk:"description"`
 }
@@ -3,17 +3,16 @@ package organization
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
 )
 
-func organizationSecretResourceToPortBody(ctx context.Context, state *OrganizationSecretModel) (*cli.OrganizationSecret, error) {
+// organizationSecretResourceToPortBody converts the planned organization secret to a request body. secretValueWo is the
+// write-only secret value, which is only available in the configuration.
+func organizationSecretResourceToPortBody(ctx context.Context, state *OrganizationSecretModel, secretValueWo types.String) (*cli.OrganizationSecret, error) {
 	secret := &cli.OrganizationSecret{
-		SecretName: state.SecretName.ValueString(),
-	}
-
-	if !state.SecretValue.IsNull() {
-		secretValue := state.SecretValue.ValueString()
-		secret.SecretValue = &secretValue
+		SecretName:  state.SecretName.ValueString(),
+		SecretValue: organizationSecretValue(state, secretValueWo),
 	}
 
 	if !state.Description.IsNull() {
@@ -24,12 +23,21 @@ func organizationSecretResourceToPortBody(ctx context.Context, state *Organizati
 	return secret, nil
 }
 
-func organizationSecretResourceToPortBodyForUpdate(ctx context.Context, state *OrganizationSecretModel) (*cli.OrganizationSecret, error) {
-	secret := &cli.OrganizationSecret{}
-
+func organizationSecretValue(state *OrganizationSecretModel, secretValueWo types.String) *string {
+	if !secretValueWo.IsNull() {
+		secretValue := secretValueWo.ValueString()
+		return &secretValue
+	}
 	if !state.SecretValue.IsNull() {
 		secretValue := state.SecretValue.ValueString()
-		secret.SecretValue = &secretValue
+		return &secretValue
+	}
+	return nil
+}
+
+func organizationSecretResourceToPortBodyForUpdate(ctx context.Context, state *OrganizationSecretModel, secretValueWo types.String) (*cli.OrganizationSecret, error) {
+	secret := &cli.OrganizationSecret{
+		SecretValue: organizationSecretValue(state, secretValueWo),
 	}
 
 	if !state.Description.IsNull() {
@@ -6,11 +6,10 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/port-labs/terraform-provider-port-labs/v2/internal/acctest"
-	"github.com/port-labs/terraform-provider-port-labs/v2/internal/utils"
 )
 
 func TestAccPortOrganization(t *testing.T) {
-	orgName := utils.GenID()
+	orgName := acctest.GenID(t)
 	var testAccOrganizationConfigCreate = fmt.Sprintf(`
 	resource "port_organization" "test" {
 		name = "%s"
@@ -18,7 +17,7 @@ func TestAccPortOrganization(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: acctest.ProviderConfig + testAccOrganizationConfigCreate,
@@ -31,8 +30,8 @@ func TestAccPortOrganization(t *testing.T) {
 }
 
 func TestAccPortOrganizationUpdate(t *testing.T) {
-	orgName := utils.GenID()
-	updatedName := utils.GenID()
+	orgName := acctest.GenID(t)
+	updatedName := acctest.GenID(t)
 	var testAccOrganizationConfigCreate = fmt.Sprintf(`
 	resource "port_organization" "test" {
 		name = "%s"
@@ -45,7 +44,7 @@ func TestAccPortOrganizationUpdate(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: acctest.ProviderConfig + testAccOrganizationConfigCreate,
@@ -64,7 +63,7 @@ func TestAccPortOrganizationUpdate(t *testing.T) {
 }
 
 func TestAccPortOrganizationImport(t *testing.T) {
-	orgName := utils.GenID()
+	orgName := acctest.GenID(t)
 	var testAccOrganizationConfigCreate = fmt.Sprintf(`
 	resource "port_organization" "test" {
 		name = "%s"
@@ -72,7 +71,7 @@ func TestAccPortOrganizationImport(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: acctest.ProviderConfig + testAccOrganizationConfigCreate,
@@ -62,13 +62,15 @@ func (r *OrganizationSecretResource) Read(ctx context.Context, req resource.Read
 
 func (r *OrganizationSecretResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var state *OrganizationSecretModel
+	var secretValueWo types.String
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("secret_value_wo"), &secretValueWo)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	secret, err := organizationSecretResourceToPortBody(ctx, state)
+	secret, err := organizationSecretResourceToPortBody(ctx, state, secretValueWo)
 	if err != nil {
 		resp.Diagnostics.AddError("failed to convert organization secret resource to body", err.Error())
 		return
@@ -88,14 +90,16 @@ func (r *OrganizationSecretResource) Create(ctx context.Context, req resource.Cr
 
 func (r *OrganizationSecretResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var state *OrganizationSecretModel
+	var secretValueWo types.String
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("secret_value_wo"), &secretValueWo)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	// Use the update-specific function that excludes secretName from the body
-	secret, err := organizationSecretResourceToPortBodyForUpdate(ctx, state)
+	secret, err := organizationSecretResourceToPortBodyForUpdate(ctx, state, secretValueWo)
 	if err != nil {
 		resp.Diagnostics.AddError("failed to convert organization secret resource to body", err.Error())
 		return
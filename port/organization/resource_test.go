@@ -2,15 +2,16 @@ package organization_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"github.com/port-labs/terraform-provider-port-labs/v2/internal/acctest"
-	"github.com/port-labs/terraform-provider-port-labs/v2/internal/utils"
 )
 
 func TestAccPortOrganizationSecret(t *testing.T) {
-	secretName := utils.GenID()
+	secretName := acctest.GenID(t)
 	var testAccOrganizationSecretConfigCreate = fmt.Sprintf(`
 	resource "port_organization_secret" "test" {
 		secret_name  = "%s"
@@ -20,7 +21,7 @@ func TestAccPortOrganizationSecret(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: acctest.ProviderConfig + testAccOrganizationSecretConfigCreate,
@@ -35,7 +36,7 @@ func TestAccPortOrganizationSecret(t *testing.T) {
 }
 
 func TestAccPortOrganizationSecretUpdate(t *testing.T) {
-	secretName := utils.GenID()
+	secretName := acctest.GenID(t)
 	var testAccOrganizationSecretConfigCreate = fmt.Sprintf(`
 	resource "port_organization_secret" "test" {
 		secret_name  = "%s"
@@ -52,7 +53,7 @@ func TestAccPortOrganizationSecretUpdate(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: acctest.ProviderConfig + testAccOrganizationSecretConfigCreate,
@@ -75,7 +76,7 @@ func TestAccPortOrganizationSecretUpdate(t *testing.T) {
 }
 
 func TestAccPortOrganizationSecretEmptyDescription(t *testing.T) {
-	secretName := utils.GenID()
+	secretName := acctest.GenID(t)
 	var testAccOrganizationSecretConfigCreate = fmt.Sprintf(`
 	resource "port_organization_secret" "test" {
 		secret_name  = "%s"
@@ -91,7 +92,7 @@ func TestAccPortOrganizationSecretEmptyDescription(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: acctest.ProviderConfig + testAccOrganizationSecretConfigCreate,
@@ -112,7 +113,7 @@ func TestAccPortOrganizationSecretEmptyDescription(t *testing.T) {
 }
 
 func TestAccPortOrganizationSecretImport(t *testing.T) {
-	secretName := utils.GenID()
+	secretName := acctest.GenID(t)
 	var testAccOrganizationSecretConfigCreate = fmt.Sprintf(`
 	resource "port_organization_secret" "test" {
 		secret_name  = "%s"
@@ -122,7 +123,7 @@ func TestAccPortOrganizationSecretImport(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: acctest.ProviderConfig + testAccOrganizationSecretConfigCreate,
@@ -144,7 +145,7 @@ func TestAccPortOrganizationSecretImport(t *testing.T) {
 }
 
 func TestAccPortOrganizationSecretDelete(t *testing.T) {
-	secretName := utils.GenID()
+	secretName := acctest.GenID(t)
 	var testAccOrganizationSecretConfigCreate = fmt.Sprintf(`
 	resource "port_organization_secret" "test" {
 		secret_name  = "%s"
@@ -154,7 +155,7 @@ func TestAccPortOrganizationSecretDelete(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: acctest.ProviderConfig + testAccOrganizationSecretConfigCreate,
@@ -169,3 +170,73 @@ func TestAccPortOrganizationSecretDelete(t *testing.T) {
 		},
 	})
 }
+
+func TestAccPortOrganizationSecretWriteOnly(t *testing.T) {
+	secretName := acctest.GenID(t)
+	var testAccOrganizationSecretConfigCreate = fmt.Sprintf(`
+	resource "port_organization_secret" "test" {
+		secret_name             = "%s"
+		secret_value_wo         = "initial-value"
+		secret_value_wo_version = 1
+		description             = "Write-only secret"
+	}`, secretName)
+
+	var testAccOrganizationSecretConfigUpdate = fmt.Sprintf(`
+	resource "port_organization_secret" "test" {
+		secret_name             = "%s"
+		secret_value_wo         = "updated-value"
+		secret_value_wo_version = 2
+		description             = "Write-only secret"
+	}`, secretName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig + testAccOrganizationSecretConfigCreate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("port_organization_secret.test", "secret_name", secretName),
+					resource.TestCheckNoResourceAttr("port_organization_secret.test", "secret_value"),
+					resource.TestCheckNoResourceAttr("port_organization_secret.test", "secret_value_wo"),
+					resource.TestCheckResourceAttr("port_organization_secret.test", "secret_value_wo_version", "1"),
+				),
+			},
+			{
+				Config: acctest.ProviderConfig + testAccOrganizationSecretConfigUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckNoResourceAttr("port_organization_secret.test", "secret_value_wo"),
+					resource.TestCheckResourceAttr("port_organization_secret.test", "secret_value_wo_version", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPortOrganizationSecretValueConflict(t *testing.T) {
+	secretName := acctest.GenID(t)
+	var testAccOrganizationSecretConfig = fmt.Sprintf(`
+	resource "port_organization_secret" "test" {
+		secret_name             = "%s"
+		secret_value            = "value"
+		secret_value_wo         = "value"
+		secret_value_wo_version = 1
+	}`, secretName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      acctest.ProviderConfig + testAccOrganizationSecretConfig,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+		},
+	})
+}
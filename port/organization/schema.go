@@ -3,10 +3,14 @@ package organization
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 )
 
 func OrganizationSecretSchema() map[string]schema.Attribute {
@@ -22,9 +26,28 @@ func OrganizationSecretSchema() map[string]schema.Attribute {
 			},
 		},
 		"secret_value": schema.StringAttribute{
-			MarkdownDescription: "The value of the organization secret",
-			Required:            true,
+			MarkdownDescription: "The value of the organization secret. Exactly one of `secret_value` and `secret_value_wo` must be set",
+			Optional:            true,
+			Sensitive:           true,
+			Validators: []validator.String{
+				stringvalidator.ExactlyOneOf(path.MatchRoot("secret_value_wo")),
+			},
+		},
+		"secret_value_wo": schema.StringAttribute{
+			MarkdownDescription: "The value of the organization secret, which is never stored in the plan or state. Requires Terraform 1.11 or later. Since its changes can't be detected, increment `secret_value_wo_version` to update the secret",
+			Optional:            true,
 			Sensitive:           true,
+			WriteOnly:           true,
+			Validators: []validator.String{
+				stringvalidator.AlsoRequires(path.MatchRoot("secret_value_wo_version")),
+			},
+		},
+		"secret_value_wo_version": schema.Int64Attribute{
+			MarkdownDescription: "The version of `secret_value_wo`. Changing it updates the secret with the current value of `secret_value_wo`",
+			Optional:            true,
+			Validators: []validator.Int64{
+				int64validator.AlsoRequires(path.MatchRoot("secret_value_wo")),
+			},
 		},
 		"description": schema.StringAttribute{
 			MarkdownDescription: "The description of the organization secret",
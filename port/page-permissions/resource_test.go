@@ -7,7 +7,6 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/port-labs/terraform-provider-port-labs/v2/internal/acctest"
-	"github.com/port-labs/terraform-provider-port-labs/v2/internal/utils"
 )
 
 func createPage(identifier string) string {
@@ -52,7 +51,7 @@ resource "port_page" "microservice_dashboard_page" {
 }
 
 func TestAccPortPagePermissionsBasic(t *testing.T) {
-	pageIdentifier := utils.GenID()
+	pageIdentifier := acctest.GenID(t)
 	err := os.Setenv("PORT_BETA_FEATURES_ENABLED", "true")
 	if err != nil {
 		t.Fatal(err)
@@ -74,7 +73,7 @@ func TestAccPortPagePermissionsBasic(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccPortPageResourceBasic + testAccBasePagePermissionsConfigUpdate,
@@ -96,14 +95,14 @@ func TestAccPortPagePermissionsBasic(t *testing.T) {
 }
 
 func TestAccPortPagePermissionsUpdateWithUsers(t *testing.T) {
-	pageIdentifier := utils.GenID()
+	pageIdentifier := acctest.GenID(t)
 	err := os.Setenv("PORT_BETA_FEATURES_ENABLED", "true")
 	if err != nil {
 		t.Fatal(err)
 	}
 	var testAccPortPageResourceBasic = createPage(pageIdentifier)
 
-	teamName := utils.GenID()
+	teamName := acctest.GenID(t)
 
 	var testAccBasePagePermissionsConfigUpdate = fmt.Sprintf(`
 	resource "port_system_blueprint" "team" {
@@ -143,7 +142,7 @@ func TestAccPortPagePermissionsUpdateWithUsers(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccPortPageResourceBasic + testAccBasePagePermissionsConfigUpdate,
@@ -166,7 +165,7 @@ func TestAccPortPagePermissionsUpdateWithUsers(t *testing.T) {
 }
 
 func TestAccPortPagePermissionsImport(t *testing.T) {
-	pageIdentifier := utils.GenID()
+	pageIdentifier := acctest.GenID(t)
 	err := os.Setenv("PORT_BETA_FEATURES_ENABLED", "true")
 	if err != nil {
 		t.Fatal(err)
@@ -188,7 +187,7 @@ func TestAccPortPagePermissionsImport(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccPortPageResourceBasic + testAccBasePagePermissionsConfigUpdate,
@@ -9,7 +9,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 
 	"github.com/port-labs/terraform-provider-port-labs/v2/internal/acctest"
-	"github.com/port-labs/terraform-provider-port-labs/v2/internal/utils"
 )
 
 func testAccCreateBlueprintConfig(identifier string) string {
@@ -31,8 +30,8 @@ func testAccCreateBlueprintConfig(identifier string) string {
 }
 
 func TestAccPortPageResourceBasicBetaEnabled(t *testing.T) {
-	blueprintIdentifier := utils.GenID()
-	pageIdentifier := utils.GenID()
+	blueprintIdentifier := acctest.GenID(t)
+	pageIdentifier := acctest.GenID(t)
 	err := os.Setenv("PORT_BETA_FEATURES_ENABLED", "true")
 	if err != nil {
 		t.Fatal(err)
@@ -64,7 +63,7 @@ resource "port_page" "microservice_blueprint_page" {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: acctest.ProviderConfig + testAccPortPageResourceBasic,
@@ -82,8 +81,8 @@ resource "port_page" "microservice_blueprint_page" {
 }
 
 func TestAccPortPageResourceBasicBetaDisabled(t *testing.T) {
-	blueprintIdentifier := utils.GenID()
-	pageIdentifier := utils.GenID()
+	blueprintIdentifier := acctest.GenID(t)
+	pageIdentifier := acctest.GenID(t)
 	err := os.Setenv("PORT_BETA_FEATURES_ENABLED", "false")
 	if err != nil {
 		t.Fatal(err)
@@ -116,7 +115,7 @@ resource "port_page" "microservice_blueprint_page" {
 	// expect to fail on beta feature not enabled
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config:      acctest.ProviderConfig + testAccPortPageResourceBasic,
@@ -127,7 +126,7 @@ resource "port_page" "microservice_blueprint_page" {
 }
 
 func TestAccPortPageResourceCreateDashboardPage(t *testing.T) {
-	pageIdentifier := utils.GenID()
+	pageIdentifier := acctest.GenID(t)
 	err := os.Setenv("PORT_BETA_FEATURES_ENABLED", "true")
 	if err != nil {
 		t.Fatal(err)
@@ -174,7 +173,7 @@ resource "port_page" "microservice_dashboard_page" {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: acctest.ProviderConfig + testAccPortPageResourceBasic,
@@ -192,7 +191,7 @@ resource "port_page" "microservice_dashboard_page" {
 }
 
 func TestAccPortPageResourceCreatePageAfterPage(t *testing.T) {
-	pageIdentifier := utils.GenID()
+	pageIdentifier := acctest.GenID(t)
 	err := os.Setenv("PORT_BETA_FEATURES_ENABLED", "true")
 	if err != nil {
 		t.Fatal(err)
@@ -236,7 +235,7 @@ resource "port_page" "microservice_dashboard_page" {
 }
 `, pageIdentifier)
 
-	pageIdentifier2 := utils.GenID()
+	pageIdentifier2 := acctest.GenID(t)
 	var testAccPortPageResourceBasic2 = fmt.Sprintf(`
 
 resource "port_page" "microservice_dashboard_page_2" {
@@ -279,7 +278,7 @@ resource "port_page" "microservice_dashboard_page_2" {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: acctest.ProviderConfig + testAccPortPageResourceBasic + testAccPortPageResourceBasic2,
@@ -302,7 +301,7 @@ resource "port_page" "microservice_dashboard_page_2" {
 }
 
 func TestAccPortPageResourceWithoutFilters(t *testing.T) {
-	pageIdentifier := utils.GenID()
+	pageIdentifier := acctest.GenID(t)
 	err := os.Setenv("PORT_BETA_FEATURES_ENABLED", "true")
 	if err != nil {
 		t.Fatal(err)
@@ -345,7 +344,7 @@ resource "port_page" "page_without_filters" {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: acctest.ProviderConfig + testAccPortPageResourceWithoutFilters,
@@ -365,7 +364,7 @@ resource "port_page" "page_without_filters" {
 // unknown values when using a for expression. This validates the fix where Widgets was
 // changed from []types.String to types.List to handle unknown list values during planning.
 func TestAccPortPageResourceWithForExpression(t *testing.T) {
-	pageIdentifier := utils.GenID()
+	pageIdentifier := acctest.GenID(t)
 	err := os.Setenv("PORT_BETA_FEATURES_ENABLED", "true")
 	if err != nil {
 		t.Fatal(err)
@@ -440,7 +439,7 @@ resource "port_page" "for_expression_page" {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: acctest.ProviderConfig + testAccPortPageResourceWithForExpression,
@@ -456,7 +455,7 @@ resource "port_page" "for_expression_page" {
 }
 
 func TestAccPortPageResourceEntityPage(t *testing.T) {
-	blueprintIdentifier := utils.GenID()
+	blueprintIdentifier := acctest.GenID(t)
 	entityPageIdentifier := blueprintIdentifier + "Entity"
 	err := os.Setenv("PORT_BETA_FEATURES_ENABLED", "true")
 	if err != nil {
@@ -621,7 +620,7 @@ resource "port_page" "entity_page" {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: acctest.ProviderConfig + blueprintConfig + entityPageConfig,
@@ -649,7 +648,7 @@ resource "port_page" "entity_page" {
 }
 
 func TestAccPortPageResourceEntityPageCreateNotSupported(t *testing.T) {
-	entityPageIdentifier := utils.GenID() + "Entity"
+	entityPageIdentifier := acctest.GenID(t) + "Entity"
 	err := os.Setenv("PORT_BETA_FEATURES_ENABLED", "true")
 	if err != nil {
 		t.Fatal(err)
@@ -667,7 +666,7 @@ resource "port_page" "entity_page" {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config:      acctest.ProviderConfig + config,
@@ -678,7 +677,7 @@ resource "port_page" "entity_page" {
 }
 
 func TestAccPortPageResourceInvalidType(t *testing.T) {
-	pageIdentifier := utils.GenID()
+	pageIdentifier := acctest.GenID(t)
 	err := os.Setenv("PORT_BETA_FEATURES_ENABLED", "true")
 	if err != nil {
 		t.Fatal(err)
@@ -694,7 +693,7 @@ resource "port_page" "invalid_type_page" {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config:      acctest.ProviderConfig + config,
@@ -705,9 +704,9 @@ resource "port_page" "invalid_type_page" {
 }
 
 func TestAccPortPageResourceWithFilters(t *testing.T) {
-	serviceBlueprintIdentifier := utils.GenID()
-	clusterBlueprintIdentifier := utils.GenID()
-	pageIdentifier := utils.GenID()
+	serviceBlueprintIdentifier := acctest.GenID(t)
+	clusterBlueprintIdentifier := acctest.GenID(t)
+	pageIdentifier := acctest.GenID(t)
 	err := os.Setenv("PORT_BETA_FEATURES_ENABLED", "true")
 	if err != nil {
 		t.Fatal(err)
@@ -884,7 +883,7 @@ resource "port_page" "page_with_filters" {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: acctest.ProviderConfig + testAccPortPageResourceWithFilters,
@@ -0,0 +1,156 @@
+package run_action
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/consts"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/poll"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/utils"
+)
+
+var _ action.Action = &RunAction{}
+var _ action.ActionWithConfigure = &RunAction{}
+
+const defaultWaitTimeout = 30 * time.Minute
+
+func NewRunAction() action.Action {
+	return &RunAction{}
+}
+
+type RunAction struct {
+	portClient *cli.PortClient
+}
+
+func (a *RunAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_run_action"
+}
+
+func (a *RunAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	a.portClient = req.ProviderData.(*cli.PortClient)
+}
+
+// run is a started action or workflow run.
+type run struct {
+	id          string
+	status      string
+	description string
+	// read returns the current status of the run.
+	read func(ctx context.Context) (string, error)
+}
+
+func (a *RunAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data RunActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	inputs, err := utils.TerraformJsonStringToGoObject(data.Inputs.ValueStringPointer())
+	if err != nil {
+		resp.Diagnostics.AddError("failed to parse the inputs", err.Error())
+		return
+	}
+	if inputs == nil {
+		inputs = &map[string]any{}
+	}
+
+	r, err := a.start(ctx, &data, *inputs)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("failed to run %s", r.description), err.Error())
+		return
+	}
+	resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Started run %s of %s", r.id, r.description)})
+
+	if !data.WaitForCompletion.ValueBool() {
+		return
+	}
+
+	timeout := defaultWaitTimeout
+	if !data.WaitTimeoutSeconds.IsNull() {
+		timeout = time.Duration(data.WaitTimeoutSeconds.ValueInt64()) * time.Second
+	}
+	waitCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	err = poll.Until(waitCtx, func(ctx context.Context) (bool, error) {
+		if consts.IsTerminalStatus(r.status) {
+			return true, nil
+		}
+		status, err := r.read(ctx)
+		if err != nil {
+			return false, err
+		}
+		if status != r.status {
+			resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Run %s of %s is %s", r.id, r.description, status)})
+		}
+		r.status = status
+		return consts.IsTerminalStatus(status), nil
+	})
+	if errors.Is(err, poll.TimeoutError) {
+		resp.Diagnostics.AddError(fmt.Sprintf("timed out waiting for run %s of %s", r.id, r.description),
+			fmt.Sprintf("The run didn't finish within %s, its last status is %s", timeout, r.status))
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("failed to read run %s of %s", r.id, r.description), err.Error())
+		return
+	}
+
+	if r.status != consts.Success && r.status != consts.Completed {
+		resp.Diagnostics.AddError(fmt.Sprintf("run %s of %s didn't succeed", r.id, r.description),
+			fmt.Sprintf("The run finished with status %s", r.status))
+	}
+}
+
+// start runs the action or the workflow of the configuration.
+func (a *RunAction) start(ctx context.Context, data *RunActionModel, inputs map[string]any) (*run, error) {
+	if !data.Workflow.IsNull() {
+		workflowID := data.Workflow.ValueString()
+		r := &run{description: fmt.Sprintf("workflow %s", workflowID)}
+		workflowRun, err := a.portClient.CreateWorkflowRun(ctx, workflowID, &cli.WorkflowRunRequest{
+			Inputs: inputs,
+			Entity: data.Entity.ValueStringPointer(),
+		})
+		if err != nil {
+			return r, err
+		}
+		r.id, r.status = workflowRun.Identifier, workflowRun.Status
+		r.read = func(ctx context.Context) (string, error) {
+			workflowRun, err := a.portClient.ReadWorkflowRun(ctx, r.id)
+			if err != nil {
+				return "", err
+			}
+			return workflowRun.Status, nil
+		}
+		return r, nil
+	}
+
+	actionID := data.Action.ValueString()
+	r := &run{description: fmt.Sprintf("action %s", actionID)}
+	actionRun, err := a.portClient.CreateActionRun(ctx, actionID, &cli.ActionRunRequest{
+		Properties: inputs,
+		Entity:     data.Entity.ValueStringPointer(),
+	})
+	if err != nil {
+		return r, err
+	}
+	r.id, r.status = actionRun.Id, actionRun.Status
+	r.read = func(ctx context.Context) (string, error) {
+		actionRun, err := a.portClient.ReadActionRun(ctx, r.id)
+		if err != nil {
+			return "", err
+		}
+		return actionRun.Status, nil
+	}
+	return r, nil
+}
@@ -0,0 +1,106 @@
+package run_action_test
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/acctest"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/consts"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/fakeport"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/poll"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPortRunAction(t *testing.T) {
+	server := fakeport.NewServer()
+	defer server.Close()
+	server.PutObject("action", "deploy", map[string]any{"identifier": "deploy", "title": "Deploy"})
+
+	progress, diagnostics := acctest.Invoke(t, server, "port_run_action", map[string]tftypes.Value{
+		"action": tftypes.NewValue(tftypes.String, "deploy"),
+		"entity": tftypes.NewValue(tftypes.String, "production"),
+		"inputs": tftypes.NewValue(tftypes.String, `{"version": "1.2.3"}`),
+	})
+	require.Empty(t, diagnostics)
+	require.Len(t, progress, 1)
+	runID := regexp.MustCompile(`^Started run (r_\d+) of action deploy$`).FindStringSubmatch(progress[0])
+	require.Len(t, runID, 2, progress[0])
+
+	run, ok := server.Object("run", runID[1])
+	require.True(t, ok)
+	assert.Equal(t, map[string]any{"version": "1.2.3"}, run["properties"])
+	assert.Equal(t, map[string]any{"identifier": "production"}, run["entity"])
+}
+
+func TestPortRunActionWaitForCompletion(t *testing.T) {
+	server := fakeport.NewServer()
+	defer server.Close()
+	server.PutObject("action", "deploy", map[string]any{"identifier": "deploy", "title": "Deploy"})
+	server.PutObject("workflow", "provision", map[string]any{"identifier": "provision", "title": "Provision"})
+
+	server.SetRunStatus(consts.Success)
+	_, diagnostics := acctest.Invoke(t, server, "port_run_action", map[string]tftypes.Value{
+		"action":              tftypes.NewValue(tftypes.String, "deploy"),
+		"wait_for_completion": tftypes.NewValue(tftypes.Bool, true),
+	})
+	assert.Empty(t, diagnostics)
+
+	server.SetRunStatus(consts.Failure)
+	_, diagnostics = acctest.Invoke(t, server, "port_run_action", map[string]tftypes.Value{
+		"workflow":            tftypes.NewValue(tftypes.String, "provision"),
+		"wait_for_completion": tftypes.NewValue(tftypes.Bool, true),
+	})
+	require.Len(t, diagnostics, 1)
+	assert.Equal(t, tfprotov6.DiagnosticSeverityError, diagnostics[0].Severity)
+	assert.Regexp(t, `^run wfr_\d+ of workflow provision didn't succeed$`, diagnostics[0].Summary)
+	assert.Equal(t, "The run finished with status FAILURE", diagnostics[0].Detail)
+}
+
+func TestPortRunActionPollsUntilFinished(t *testing.T) {
+	defer poll.SetInterval(10 * time.Millisecond)()
+	server := fakeport.NewServer()
+	defer server.Close()
+	server.PutObject("action", "deploy", map[string]any{"identifier": "deploy", "title": "Deploy"})
+
+	// Runs are created IN_PROGRESS, and succeed on their third read.
+	reads := 0
+	server.OnRead("run", func(run map[string]any) {
+		reads++
+		if reads == 3 {
+			run["status"] = consts.Success
+		}
+	})
+	progress, diagnostics := acctest.Invoke(t, server, "port_run_action", map[string]tftypes.Value{
+		"action":              tftypes.NewValue(tftypes.String, "deploy"),
+		"wait_for_completion": tftypes.NewValue(tftypes.Bool, true),
+	})
+	assert.Empty(t, diagnostics)
+	assert.Equal(t, 3, reads)
+	require.Len(t, progress, 2)
+	assert.Regexp(t, `^Run r_\d+ of action deploy is SUCCESS$`, progress[1])
+}
+
+func TestPortRunActionTimeout(t *testing.T) {
+	defer poll.SetInterval(100 * time.Millisecond)()
+	server := fakeport.NewServer()
+	defer server.Close()
+	server.PutObject("workflow", "provision", map[string]any{"identifier": "provision", "title": "Provision"})
+
+	// The wait times out while the run is being read, which is reported as a timeout rather than a failed read.
+	server.OnRead("workflowRun", func(run map[string]any) { time.Sleep(time.Second) })
+	start := time.Now()
+	_, diagnostics := acctest.Invoke(t, server, "port_run_action", map[string]tftypes.Value{
+		"workflow":             tftypes.NewValue(tftypes.String, "provision"),
+		"wait_for_completion":  tftypes.NewValue(tftypes.Bool, true),
+		"wait_timeout_seconds": tftypes.NewValue(tftypes.Number, 1),
+	})
+	assert.Less(t, time.Since(start), 5*time.Second)
+	require.Len(t, diagnostics, 1)
+	assert.Equal(t, tfprotov6.DiagnosticSeverityError, diagnostics[0].Severity)
+	assert.Regexp(t, `^timed out waiting for run wfr_\d+ of workflow provision$`, diagnostics[0].Summary)
+	assert.Equal(t, "The run didn't finish within 1s, its last status is IN_PROGRESS", diagnostics[0].Detail)
+}
@@ -0,0 +1,12 @@
+package run_action
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+type RunActionModel struct {
+	Action             types.String `tfsdk:"action"`
+	Workflow           types.String `tfsdk:"workflow"`
+	Inputs             types.String `tfsdk:"inputs"`
+	Entity             types.String `tfsdk:"entity"`
+	WaitForCompletion  types.Bool   `tfsdk:"wait_for_completion"`
+	WaitTimeoutSeconds types.Int64  `tfsdk:"wait_timeout_seconds"`
+}
@@ -0,0 +1,87 @@
+package run_action
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+func RunActionSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"action": schema.StringAttribute{
+			MarkdownDescription: "The identifier of the self-service action to run",
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.ExactlyOneOf(path.MatchRoot("workflow")),
+			},
+		},
+		"workflow": schema.StringAttribute{
+			MarkdownDescription: "The identifier of the workflow to run, through its self-service trigger",
+			Optional:            true,
+		},
+		"inputs": schema.StringAttribute{
+			MarkdownDescription: "The inputs of the run, in JSON format",
+			Optional:            true,
+		},
+		"entity": schema.StringAttribute{
+			MarkdownDescription: "The identifier of the entity to run the action on",
+			Optional:            true,
+		},
+		"wait_for_completion": schema.BoolAttribute{
+			MarkdownDescription: "Wait until the run finishes, and fail when it doesn't succeed. Defaults to `false`",
+			Optional:            true,
+		},
+		"wait_timeout_seconds": schema.Int64Attribute{
+			MarkdownDescription: "The amount of seconds to wait for the run to finish, when `wait_for_completion` is set. Defaults to `1800`",
+			Optional:            true,
+			Validators: []validator.Int64{
+				int64validator.AtLeast(1),
+			},
+		},
+	}
+}
+
+func (a *RunAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: RunActionMarkdownDescription,
+		Attributes:          RunActionSchema(),
+	}
+}
+
+var RunActionMarkdownDescription = `
+
+# Run Action
+
+Runs a self-service action or a workflow, e.g. to start a day-2 operation that Port orchestrates once Terraform created a resource.
+
+Requires Terraform 1.14 or later.
+
+## Example Usage
+
+` + "```hcl" + `
+action "port_run_action" "register_cluster" {
+  config {
+    action              = "register_cluster"
+    entity              = "production"
+    inputs              = jsonencode({ name = aws_eks_cluster.this.name })
+    wait_for_completion = true
+  }
+}
+
+resource "aws_eks_cluster" "this" {
+  # ...
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.port_run_action.register_cluster]
+    }
+  }
+}
+` + "```" + `
+`
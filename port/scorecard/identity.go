@@ -0,0 +1,52 @@
+package scorecard
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.ResourceWithIdentity = &ScorecardResource{}
+
+type ScorecardIdentityModel struct {
+	Blueprint  types.String `tfsdk:"blueprint"`
+	Identifier types.String `tfsdk:"identifier"`
+}
+
+func (r *ScorecardResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"blueprint": identityschema.StringAttribute{
+				Description:       "The identifier of the blueprint of the scorecard",
+				RequiredForImport: true,
+			},
+			"identifier": identityschema.StringAttribute{
+				Description:       "The identifier of the scorecard",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func scorecardIdentity(blueprint string, identifier string) *ScorecardIdentityModel {
+	return &ScorecardIdentityModel{
+		Blueprint:  types.StringValue(blueprint),
+		Identifier: types.StringValue(identifier),
+	}
+}
+
+// importStateFromIdentity imports a scorecard by the identity Terraform passes when the import block sets `identity`
+// instead of `id`, e.g. in the configuration generated by `terraform query`.
+func importStateFromIdentity(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var identity *ScorecardIdentityModel
+	resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("blueprint"), identity.Blueprint)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("identifier"), identity.Identifier)...)
+}
@@ -0,0 +1,94 @@
+package scorecard
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
+)
+
+var _ list.ListResourceWithConfigure = &ScorecardListResource{}
+
+func NewScorecardListResource() list.ListResource {
+	return &ScorecardListResource{}
+}
+
+// ScorecardListResource lists the scorecards of the organization for `terraform query`.
+type ScorecardListResource struct {
+	portClient *cli.PortClient
+}
+
+type ScorecardListModel struct {
+	Blueprint types.String `tfsdk:"blueprint"`
+}
+
+func (r *ScorecardListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_scorecard"
+}
+
+func (r *ScorecardListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.portClient = req.ProviderData.(*cli.PortClient)
+}
+
+func (r *ScorecardListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the scorecards of the organization",
+		Attributes: map[string]schema.Attribute{
+			"blueprint": schema.StringAttribute{
+				MarkdownDescription: "The identifier of a blueprint to only list the scorecards of",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *ScorecardListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config ScorecardListModel
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	scorecards, err := r.portClient.ReadScorecards(ctx)
+	if err != nil {
+		diags.AddError("failed to read scorecards", err.Error())
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	scorecardResource := &ScorecardResource{portClient: r.portClient}
+	stream.Results = func(push func(list.ListResult) bool) {
+		var count int64
+		for _, s := range scorecards {
+			if !config.Blueprint.IsNull() && s.Blueprint != config.Blueprint.ValueString() {
+				continue
+			}
+			if req.Limit > 0 && count >= req.Limit {
+				return
+			}
+			count++
+
+			result := req.NewListResult(ctx)
+			result.DisplayName = s.Title
+			result.Diagnostics.Append(result.Identity.Set(ctx, scorecardIdentity(s.Blueprint, s.Identifier))...)
+
+			if req.IncludeResource && !result.Diagnostics.HasError() {
+				state := &ScorecardModel{}
+				scorecardResource.refreshScorecardState(ctx, state, &s, s.Blueprint)
+				result.Diagnostics.Append(result.Resource.Set(ctx, state)...)
+			}
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,64 @@
+package scorecard_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/acctest"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/acctest/fakeclient"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPortScorecardList(t *testing.T) {
+	ctx := context.Background()
+	server, client := fakeclient.New(t)
+	for _, identifier := range []string{"microservice", "environment"} {
+		_, err := client.CreateBlueprint(ctx, &cli.Blueprint{
+			Identifier: identifier,
+			Title:      identifier,
+			Schema: cli.BlueprintSchema{Properties: map[string]cli.BlueprintProperty{
+				"language": {Type: "string"},
+			}},
+		}, nil)
+		require.NoError(t, err)
+	}
+	rules := []cli.Rule{{
+		Identifier: "has_language",
+		Title:      "Has language",
+		Level:      "Gold",
+		Query: cli.Query{
+			Combinator: "and",
+			Conditions: []any{map[string]any{"property": "language", "operator": "isNotEmpty"}},
+		},
+	}}
+	for _, s := range []cli.Scorecard{
+		{Identifier: "readiness", Title: "Readiness", Blueprint: "microservice", Rules: rules},
+		{Identifier: "ownership", Title: "Ownership", Blueprint: "microservice", Rules: rules},
+		{Identifier: "health", Title: "Health", Blueprint: "environment", Rules: rules},
+	} {
+		_, err := client.CreateScorecard(ctx, s.Blueprint, &s)
+		require.NoError(t, err)
+	}
+
+	results := acctest.List(t, server, "port_scorecard", nil, false)
+	assert.Len(t, results, 3)
+
+	results = acctest.List(t, server, "port_scorecard", map[string]tftypes.Value{
+		"blueprint": tftypes.NewValue(tftypes.String, "microservice"),
+	}, true)
+	require.Len(t, results, 2, "only the scorecards of the blueprint are listed")
+	assert.Equal(t, "Ownership", results[0].DisplayName)
+	assert.Equal(t, tftypes.NewValue(tftypes.String, "microservice"), results[0].Identity["blueprint"])
+	assert.Equal(t, tftypes.NewValue(tftypes.String, "ownership"), results[0].Identity["identifier"])
+	assert.Equal(t, tftypes.NewValue(tftypes.String, "Ownership"), results[0].Resource["title"])
+
+	imported := acctest.ImportFromIdentity(t, server, "port_scorecard", results[0].Identity)
+	assert.Equal(t, results[0].Resource["id"], imported["id"])
+	assert.Equal(t, results[0].Resource["title"], imported["title"])
+	assert.False(t, imported["rules"].IsNull())
+	assert.Equal(t, results[0].Resource["rules"], imported["rules"])
+	assert.Equal(t, results[0].Resource["levels"], imported["levels"])
+}
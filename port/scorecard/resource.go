@@ -44,6 +44,7 @@ func (r *ScorecardResource) Read(ctx context.Context, req resource.ReadRequest,
 
 	identifier := state.Identifier.ValueString()
 	blueprintIdentifier := state.Blueprint.ValueString()
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, scorecardIdentity(blueprintIdentifier, identifier))...)
 	s, statusCode, err := r.portClient.ReadScorecard(ctx, blueprintIdentifier, identifier)
 	if err != nil {
 		if statusCode == 404 {
@@ -81,6 +82,7 @@ func (r *ScorecardResource) Create(ctx context.Context, req resource.CreateReque
 	r.refreshScorecardState(ctx, state, sp, state.Blueprint.ValueString())
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, scorecardIdentity(state.Blueprint.ValueString(), sp.Identifier))...)
 }
 
 func (r *ScorecardResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -116,6 +118,7 @@ func (r *ScorecardResource) Update(ctx context.Context, req resource.UpdateReque
 	r.refreshScorecardState(ctx, state, sp, state.Blueprint.ValueString())
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, scorecardIdentity(state.Blueprint.ValueString(), sp.Identifier))...)
 }
 
 func (r *ScorecardResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -135,6 +138,11 @@ func (r *ScorecardResource) Delete(ctx context.Context, req resource.DeleteReque
 }
 
 func (r *ScorecardResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		importStateFromIdentity(ctx, req, resp)
+		return
+	}
+
 	idParts := strings.Split(req.ID, ":")
 
 	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
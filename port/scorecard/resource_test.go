@@ -6,7 +6,6 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/port-labs/terraform-provider-port-labs/v2/internal/acctest"
-	"github.com/port-labs/terraform-provider-port-labs/v2/internal/utils"
 )
 
 func testAccCreateBlueprintConfig(identifier string) string {
@@ -40,8 +39,8 @@ func testAccCreateBlueprintConfig(identifier string) string {
 }
 
 func TestAccPortScorecardBasic(t *testing.T) {
-	blueprintIdentifier := utils.GenID()
-	scorecardIdentifier := utils.GenID()
+	blueprintIdentifier := acctest.GenID(t)
+	scorecardIdentifier := acctest.GenID(t)
 	var testAccActionConfigCreate = testAccCreateBlueprintConfig(blueprintIdentifier) + fmt.Sprintf(`
 	resource "port_scorecard" "test" {
 		identifier = "%s"
@@ -66,7 +65,7 @@ func TestAccPortScorecardBasic(t *testing.T) {
 	  }`, scorecardIdentifier, blueprintIdentifier)
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: acctest.ProviderConfig + testAccActionConfigCreate,
@@ -87,8 +86,8 @@ func TestAccPortScorecardBasic(t *testing.T) {
 }
 
 func TestAccPortScorecard(t *testing.T) {
-	blueprintIdentifier := utils.GenID()
-	scorecardIdentifier := utils.GenID()
+	blueprintIdentifier := acctest.GenID(t)
+	scorecardIdentifier := acctest.GenID(t)
 	var testAccActionConfigCreate = testAccCreateBlueprintConfig(blueprintIdentifier) + fmt.Sprintf(`
 	resource "port_scorecard" "test" {
 		identifier = "%s"
@@ -197,7 +196,7 @@ func TestAccPortScorecard(t *testing.T) {
 	  }`, scorecardIdentifier, blueprintIdentifier)
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: acctest.ProviderConfig + testAccActionConfigCreate,
@@ -228,7 +227,7 @@ func TestAccPortScorecard(t *testing.T) {
 	})
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: acctest.ProviderConfigNoEscapeHTML + testAccActionConfigCreateNoEscapeHTML,
@@ -260,8 +259,8 @@ func TestAccPortScorecard(t *testing.T) {
 }
 
 func TestAccPortScorecardUpdate(t *testing.T) {
-	blueprintIdentifier := utils.GenID()
-	scorecardIdentifier := utils.GenID()
+	blueprintIdentifier := acctest.GenID(t)
+	scorecardIdentifier := acctest.GenID(t)
 	var testAccActionConfigCreate = testAccCreateBlueprintConfig(blueprintIdentifier) + fmt.Sprintf(`
 	resource "port_scorecard" "test" {
 		identifier = "%s"
@@ -336,7 +335,7 @@ func TestAccPortScorecardUpdate(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 
 		Steps: []resource.TestStep{
 			{
@@ -381,8 +380,8 @@ func TestAccPortScorecardUpdate(t *testing.T) {
 }
 
 func TestAccPortScorecardImport(t *testing.T) {
-	blueprintIdentifier := utils.GenID()
-	scorecardIdentifier := utils.GenID()
+	blueprintIdentifier := acctest.GenID(t)
+	scorecardIdentifier := acctest.GenID(t)
 	var testAccActionConfigCreate = testAccCreateBlueprintConfig(blueprintIdentifier) + fmt.Sprintf(`
 	resource "port_scorecard" "test" {
 		identifier = "%s"
@@ -408,7 +407,7 @@ func TestAccPortScorecardImport(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 
 		Steps: []resource.TestStep{
 			{
@@ -437,9 +436,9 @@ func TestAccPortScorecardImport(t *testing.T) {
 }
 
 func TestAccPortScorecardUpdateIdentifier(t *testing.T) {
-	blueprintIdentifier := utils.GenID()
-	scorecardIdentifier := utils.GenID()
-	scorecardIdentifierUpdated := utils.GenID()
+	blueprintIdentifier := acctest.GenID(t)
+	scorecardIdentifier := acctest.GenID(t)
+	scorecardIdentifierUpdated := acctest.GenID(t)
 	var testAccActionConfigCreate = testAccCreateBlueprintConfig(blueprintIdentifier) + fmt.Sprintf(`
 	resource "port_scorecard" "test" {
 		identifier = "%s"
@@ -487,7 +486,7 @@ func TestAccPortScorecardUpdateIdentifier(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 
 		Steps: []resource.TestStep{
 			{
@@ -525,8 +524,8 @@ func TestAccPortScorecardUpdateIdentifier(t *testing.T) {
 }
 
 func TestAccPortScorecardRuleOrderPreservation(t *testing.T) {
-	blueprintIdentifier := utils.GenID()
-	scorecardIdentifier := utils.GenID()
+	blueprintIdentifier := acctest.GenID(t)
+	scorecardIdentifier := acctest.GenID(t)
 
 	// Create scorecard with rules in a specific non-alphabetical order
 	// Order: "zebra" (Z), "alpha" (A), "beta" (B)
@@ -630,7 +629,7 @@ func TestAccPortScorecardRuleOrderPreservation(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: acctest.ProviderConfig + testAccConfigCreate,
@@ -47,6 +47,7 @@ func (d *SearchDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 
 	data.ID = types.StringValue(data.GenerateID())
 	data.MatchingBlueprints = goStringListToTFList(searchResult.MatchingBlueprints)
+	data.Truncated = types.BoolValue(searchResult.Truncated)
 
 	blueprints := make(map[string]cli.Blueprint)
 	for _, blueprint := range searchResult.MatchingBlueprints {
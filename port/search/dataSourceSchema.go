@@ -3,9 +3,11 @@ package search
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -182,11 +184,24 @@ func Schema() map[string]schema.Attribute {
 			MarkdownDescription: "Attach title to relation",
 			Optional:            true,
 		},
+		"max_results": schema.Int64Attribute{
+			MarkdownDescription: "The maximum amount of entities to return. A `limit` in the query caps the entities " +
+				"the same way. When neither is set, all the matching entities are returned",
+			Optional: true,
+			Validators: []validator.Int64{
+				int64validator.AtLeast(1),
+			},
+		},
 		"matching_blueprints": schema.ListAttribute{
 			MarkdownDescription: "The matching blueprints for the search query",
 			Computed:            true,
 			ElementType:         types.StringType,
 		},
+		"truncated": schema.BoolAttribute{
+			MarkdownDescription: "Whether more entities match the search query than were returned because of " +
+				"`max_results` or the `limit` of the query",
+			Computed: true,
+		},
 		"entities": schema.ListNestedAttribute{
 			MarkdownDescription: "A list of entities matching the search query",
 			Computed:            true,
@@ -244,6 +259,24 @@ data "port_search" "ads_service" {
 
 ` + "\n```" + `
 
+### Search with a cap on the amount of returned entities:
+
+All the matching entities are fetched page by page. Use ` + "`max_results`" + ` to limit the amount of returned entities,
+` + "`truncated`" + ` will be ` + "`true`" + ` when more entities matched the query.
+
+` + "```hcl" + `
+
+data "port_search" "some_services" {
+  max_results = 100
+  query = jsonencode({
+    "combinator" : "and", "rules" : [
+      { "operator" : "=", "property" : "$blueprint", "value" : "Service" },
+    ]
+  })
+}
+
+` + "\n```" + `
+
 ### Scorecards automation example
 In this example we are creating a jira task for each service that its Ownership Scorecard hasn't reached Gold level : 
 
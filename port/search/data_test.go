@@ -2,7 +2,6 @@ package search_test
 
 import (
 	"fmt"
-	"github.com/port-labs/terraform-provider-port-labs/v2/internal/utils"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -10,7 +9,7 @@ import (
 )
 
 func TestAccPortEntity(t *testing.T) {
-	identifier := utils.GenID()
+	identifier := acctest.GenID(t)
 	var testAccActionConfigCreate = fmt.Sprintf(`
 	resource "port_blueprint" "microservice" {
 		title = "TF Provider Test BP0"
@@ -103,7 +102,7 @@ func TestAccPortEntity(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 
 		Steps: []resource.TestStep{
 			{
@@ -149,7 +148,7 @@ func TestAccPortEntity(t *testing.T) {
 }
 
 func TestAccPortEntityWithNulls(t *testing.T) {
-	identifier := utils.GenID()
+	identifier := acctest.GenID(t)
 	var testAccActionConfigCreate = fmt.Sprintf(`
 	resource "port_blueprint" "microservice" {
 		title = "TF Provider Test BP0"
@@ -242,7 +241,7 @@ func TestAccPortEntityWithNulls(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 
 		Steps: []resource.TestStep{
 			{
@@ -280,8 +279,8 @@ func TestAccPortEntityWithNulls(t *testing.T) {
 }
 
 func TestAccPortEntityWithRelation(t *testing.T) {
-	identifier := utils.GenID()
-	identifier2 := utils.GenID()
+	identifier := acctest.GenID(t)
+	identifier2 := acctest.GenID(t)
 	var testAccActionConfigCreate = fmt.Sprintf(`
 	resource "port_blueprint" "microservice" {
 		title = "TF Provider Test BP0"
@@ -363,7 +362,7 @@ func TestAccPortEntityWithRelation(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 
 		Steps: []resource.TestStep{
 			{
@@ -397,8 +396,8 @@ func TestAccPortEntityWithRelation(t *testing.T) {
 }
 
 func TestAccPortEntityWithManyRelation(t *testing.T) {
-	identifier1 := utils.GenID()
-	identifier2 := utils.GenID()
+	identifier1 := acctest.GenID(t)
+	identifier2 := acctest.GenID(t)
 	var testAccActionConfigCreate = fmt.Sprintf(`
 	resource "port_blueprint" "microservice" {
 		title = "TF Provider Test BP0"
@@ -503,7 +502,7 @@ func TestAccPortEntityWithManyRelation(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 
 		Steps: []resource.TestStep{
 			{
@@ -547,8 +546,8 @@ func TestAccPortEntityWithManyRelation(t *testing.T) {
 }
 
 func TestAccPortEntityWithEmptyRelation(t *testing.T) {
-	identifier := utils.GenID()
-	identifier2 := utils.GenID()
+	identifier := acctest.GenID(t)
+	identifier2 := acctest.GenID(t)
 	var testAccActionConfigCreate = fmt.Sprintf(`
 	resource "port_blueprint" "microservice" {
 		title = "TF Provider Test BP0"
@@ -609,7 +608,7 @@ func TestAccPortEntityWithEmptyRelation(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 
 		Steps: []resource.TestStep{
 			{
@@ -635,8 +634,8 @@ func TestAccPortEntityWithEmptyRelation(t *testing.T) {
 }
 
 func TestAccPortEntityUpdateProp(t *testing.T) {
-	identifier := utils.GenID()
-	entityIdentifier := utils.GenID()
+	identifier := acctest.GenID(t)
+	entityIdentifier := acctest.GenID(t)
 	var testAccActionConfigCreate = fmt.Sprintf(`
 	resource "port_blueprint" "microservice" {
 		title = "TF Provider Test BP0"
@@ -697,7 +696,7 @@ func TestAccPortEntityUpdateProp(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 
 		Steps: []resource.TestStep{
 			{
@@ -735,3 +734,55 @@ func TestAccPortEntityUpdateProp(t *testing.T) {
 		},
 	})
 }
+
+func TestAccPortSearchMaxResults(t *testing.T) {
+	identifier := acctest.GenID(t)
+	var testAccEntitiesConfig = fmt.Sprintf(`
+	resource "port_blueprint" "microservice" {
+		title = "TF Provider Test BP0"
+		icon = "Terraform"
+		identifier = "%s"
+	}
+	resource "port_entity" "microservice" {
+		count = 3
+		title = "TF Provider Test Entity${count.index}"
+		blueprint = port_blueprint.microservice.identifier
+	}
+	`, identifier)
+
+	var testSearchQuery = fmt.Sprintf(`
+	data "port_search" "all" {
+		query = jsonencode({
+			"combinator" : "and", "rules" : [
+				{ "operator" : "=", "property" : "$blueprint", "value" : "%s" },
+			]
+		})
+		depends_on = [port_entity.microservice]
+	}
+	data "port_search" "capped" {
+		max_results = 2
+		query = jsonencode({
+			"combinator" : "and", "rules" : [
+				{ "operator" : "=", "property" : "$blueprint", "value" : "%s" },
+			]
+		})
+		depends_on = [port_entity.microservice]
+	}`, identifier, identifier)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
+
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig + testAccEntitiesConfig + testSearchQuery,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.port_search.all", "entities.#", "3"),
+					resource.TestCheckResourceAttr("data.port_search.all", "truncated", "false"),
+					resource.TestCheckResourceAttr("data.port_search.capped", "entities.#", "2"),
+					resource.TestCheckResourceAttr("data.port_search.capped", "truncated", "true"),
+				),
+			},
+		},
+	})
+}
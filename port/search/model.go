@@ -62,7 +62,9 @@ type SearchDataModel struct {
 	Include                     []types.String `tfsdk:"include"`
 	Exclude                     []types.String `tfsdk:"exclude"`
 	AttachTitleToRelation       types.Bool     `tfsdk:"attach_title_to_relation"`
+	MaxResults                  types.Int64    `tfsdk:"max_results"`
 	MatchingBlueprints          []types.String `tfsdk:"matching_blueprints"`
+	Truncated                   types.Bool     `tfsdk:"truncated"`
 	Entities                    []EntityModel  `tfsdk:"entities"`
 }
 
@@ -78,6 +80,9 @@ func (m *SearchDataModel) GenerateID() string {
 		sb.WriteString(exclude.ValueString())
 	}
 	sb.WriteString(fmt.Sprintf("%t", m.AttachTitleToRelation.ValueBool()))
+	if !m.MaxResults.IsNull() {
+		sb.WriteString(fmt.Sprintf("%d", m.MaxResults.ValueInt64()))
+	}
 
 	// Compute the SHA-256 hash of the concatenated string
 	hash := sha256.Sum256([]byte(sb.String()))
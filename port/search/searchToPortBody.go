@@ -12,11 +12,17 @@ func searchResourceToPortBody(state *SearchDataModel) (*cli.SearchRequestQuery,
 		return nil, err
 	}
 
+	var maxResults *int
+	if !state.MaxResults.IsNull() {
+		maxResults = utils.PtrTo(int(state.MaxResults.ValueInt64()))
+	}
+
 	return &cli.SearchRequestQuery{
 		Query:                       query,
 		ExcludeCalculatedProperties: state.ExcludeCalculatedProperties.ValueBoolPointer(),
 		Include:                     flex.TerraformStringListToGoArray(state.Include),
 		Exclude:                     flex.TerraformStringListToGoArray(state.Exclude),
 		AttachTitleToRelation:       state.AttachTitleToRelation.ValueBoolPointer(),
+		MaxResults:                  maxResults,
 	}, nil
 }
@@ -1,6 +1,7 @@
 package system_blueprint
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/port-labs/terraform-provider-port-labs/v2/port/blueprint"
 )
@@ -13,4 +14,5 @@ type SystemBlueprintModel struct {
 	MirrorProperties      map[string]blueprint.MirrorPropertyModel      `tfsdk:"mirror_properties"`
 	CalculationProperties map[string]blueprint.CalculationPropertyModel `tfsdk:"calculation_properties"`
 	IncludeInGlobalSearch types.Bool                                    `tfsdk:"include_in_global_search"`
+	Timeouts              timeouts.Value                                `tfsdk:"timeouts"`
 }
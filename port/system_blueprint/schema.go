@@ -3,6 +3,7 @@ package system_blueprint
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -53,5 +54,9 @@ func (r *Resource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *r
 			"calculation_properties":   blueprintSchemas["calculation_properties"],
 			"include_in_global_search": blueprintSchemas["include_in_global_search"],
 		},
+		Blocks: map[string]schema.Block{
+			// System blueprints are never deleted, only the extensions of the resource are removed from them.
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Update: true}),
+		},
 	}
 }
@@ -1,6 +1,7 @@
 package team
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -13,4 +14,5 @@ type TeamModel struct {
 	CreatedAt    types.String   `tfsdk:"created_at"`
 	UpdatedAt    types.String   `tfsdk:"updated_at"`
 	ProviderName types.String   `tfsdk:"provider_name"`
+	Timeouts     timeouts.Value `tfsdk:"timeouts"`
 }
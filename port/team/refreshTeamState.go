@@ -15,7 +15,10 @@ func refreshTeamState(ctx context.Context, state *TeamModel, t *cli.Team) error
 	state.Name = types.StringValue(t.Name)
 	state.Description = flex.GoStringToFramework(t.Description)
 	state.ProviderName = flex.GoStringToFramework(&t.Provider)
-	state.Identifier = types.StringPointerValue(t.Identifier)
+	// The team is read without an identifier while its entity is being created, so the state keeps its identifier.
+	if t.Identifier != nil {
+		state.Identifier = types.StringPointerValue(t.Identifier)
+	}
 
 	if len(t.Users) != 0 {
 		state.Users = make([]types.String, len(t.Users))
@@ -7,6 +7,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/consts"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/flex"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces
@@ -45,6 +47,14 @@ func (r *TeamResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, consts.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	name := state.Name.ValueString()
 	t, statusCode, err := r.portClient.ReadTeam(ctx, name)
 	if err != nil {
@@ -72,13 +82,21 @@ func (r *TeamResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
+	createTimeout, diags := state.Timeouts.Create(ctx, consts.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	t, err := TeamResourceToPortBody(ctx, state)
 	if err != nil {
 		resp.Diagnostics.AddError("failed to convert team resource to body", err.Error())
 		return
 	}
 
-	tp, err := r.portClient.CreateTeam(ctx, &t.PortTeam)
+	tp, err := r.portClient.CreateTeam(ctx, &t.PortTeam, flex.TimeoutIsSet(state.Timeouts, "create"))
 	if err != nil {
 		resp.Diagnostics.AddError("failed to create team", err.Error())
 		return
@@ -112,6 +130,14 @@ func (r *TeamResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
+	updateTimeout, diags := state.Timeouts.Update(ctx, consts.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	t, err := TeamResourceToPortBody(ctx, state)
 	if err != nil {
 		resp.Diagnostics.AddError("failed to convert team resource to body", err.Error())
@@ -119,7 +145,7 @@ func (r *TeamResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	}
 
 	oldTeamName := currentState.Name.ValueString()
-	tp, err := r.portClient.UpdateTeam(ctx, oldTeamName, &t.PortTeam)
+	tp, err := r.portClient.UpdateTeam(ctx, oldTeamName, &t.PortTeam, flex.TimeoutIsSet(state.Timeouts, "update"))
 	if err != nil {
 		resp.Diagnostics.AddError("failed to update the team", err.Error())
 		return
@@ -137,6 +163,14 @@ func (r *TeamResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, consts.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	err := r.portClient.DeleteTeam(ctx, state.Name.ValueString())
 
 	if err != nil {
@@ -5,14 +5,12 @@ import (
 	"os"
 	"testing"
 
-	"github.com/port-labs/terraform-provider-port-labs/v2/internal/utils"
-
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/port-labs/terraform-provider-port-labs/v2/internal/acctest"
 )
 
 func TestAccPortTeam(t *testing.T) {
-	teamName := utils.GenID()
+	teamName := acctest.GenID(t)
 	var testAccTeamConfigCreate = fmt.Sprintf(`
 	resource "port_team" "team" {
 		name = "%s"
@@ -22,7 +20,7 @@ func TestAccPortTeam(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: acctest.ProviderConfig + testAccTeamConfigCreate,
@@ -37,7 +35,7 @@ func TestAccPortTeam(t *testing.T) {
 }
 
 func TestAccPortTeamUpdate(t *testing.T) {
-	teamName := utils.GenID()
+	teamName := acctest.GenID(t)
 	userName := os.Getenv("CI_USER_NAME")
 	var testAccTeamConfigCreate = fmt.Sprintf(`
 	resource "port_team" "team" {
@@ -55,7 +53,7 @@ func TestAccPortTeamUpdate(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: acctest.ProviderConfig + testAccTeamConfigCreate,
@@ -79,7 +77,7 @@ func TestAccPortTeamUpdate(t *testing.T) {
 }
 
 func TestAccPortTeamEmptyDescription(t *testing.T) {
-	teamName := utils.GenID()
+	teamName := acctest.GenID(t)
 	var testAccTeamConfigCreate = fmt.Sprintf(`
 	resource "port_team" "team" {
 		name = "%s"
@@ -94,7 +92,7 @@ func TestAccPortTeamEmptyDescription(t *testing.T) {
 	}`, teamName)
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: acctest.ProviderConfig + testAccTeamConfigCreate,
@@ -117,8 +115,8 @@ func TestAccPortTeamEmptyDescription(t *testing.T) {
 }
 
 func TestAccPortTeamNameUpdate(t *testing.T) {
-	initialTeamName := utils.GenID()
-	updatedTeamName := utils.GenID()
+	initialTeamName := acctest.GenID(t)
+	updatedTeamName := acctest.GenID(t)
 	var testAccTeamConfigCreate = fmt.Sprintf(`
 	resource "port_team" "team" {
 		name = "%s"
@@ -135,7 +133,7 @@ func TestAccPortTeamNameUpdate(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: acctest.ProviderConfig + testAccTeamConfigCreate,
@@ -158,7 +156,7 @@ func TestAccPortTeamNameUpdate(t *testing.T) {
 }
 
 func TestAccPortTeamImport(t *testing.T) {
-	teamName := utils.GenID()
+	teamName := acctest.GenID(t)
 	userName := os.Getenv("CI_USER_NAME")
 	var testAccTeamConfigCreate = fmt.Sprintf(`
 	resource "port_team" "team" {
@@ -169,7 +167,7 @@ func TestAccPortTeamImport(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: acctest.ProviderConfig + testAccTeamConfigCreate,
@@ -6,6 +6,8 @@ import (
 
 type SecurityModel struct {
 	Secret                types.String `tfsdk:"secret"`
+	SecretWo              types.String `tfsdk:"secret_wo"`
+	SecretWoVersion       types.Int64  `tfsdk:"secret_wo_version"`
 	SignatureHeaderName   types.String `tfsdk:"signature_header_name"`
 	SignatureAlgorithm    types.String `tfsdk:"signature_algorithm"`
 	SignaturePrefix       types.String `tfsdk:"signature_prefix"`
@@ -25,13 +25,23 @@ func refreshWebhookState(ctx context.Context, state *WebhookModel, w *cli.Webhoo
 	state.Enabled = flex.GoBoolToFramework(w.Enabled)
 
 	if w.Security.RequestIdentifierPath != nil || w.Security.Secret != nil || w.Security.SignatureHeaderName != nil || w.Security.SignatureAlgorithm != nil || w.Security.SignaturePrefix != nil {
+		secretWoVersion := types.Int64Null()
+		if state.Security != nil {
+			secretWoVersion = state.Security.SecretWoVersion
+		}
 		state.Security = &SecurityModel{
 			Secret:                flex.GoStringToFramework(w.Security.Secret),
+			SecretWo:              types.StringNull(),
+			SecretWoVersion:       secretWoVersion,
 			SignatureHeaderName:   flex.GoStringToFramework(w.Security.SignatureHeaderName),
 			SignatureAlgorithm:    flex.GoStringToFramework(w.Security.SignatureAlgorithm),
 			SignaturePrefix:       flex.GoStringToFramework(w.Security.SignaturePrefix),
 			RequestIdentifierPath: flex.GoStringToFramework(w.Security.RequestIdentifierPath),
 		}
+		// A write-only secret is returned by the API like any other secret, but must never be stored in the state.
+		if !secretWoVersion.IsNull() {
+			state.Security.Secret = types.StringNull()
+		}
 	}
 
 	if len(w.Mappings) > 0 {
@@ -13,6 +13,8 @@ import (
 var _ resource.Resource = &WebhookResource{}
 var _ resource.ResourceWithImportState = &WebhookResource{}
 
+var secretWoPath = path.Root("security").AtName("secret_wo")
+
 func NewWebhookResource() resource.Resource {
 	return &WebhookResource{}
 }
@@ -63,13 +65,15 @@ func (r *WebhookResource) Read(ctx context.Context, req resource.ReadRequest, re
 
 func (r *WebhookResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var state *WebhookModel
+	var secretWo types.String
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, secretWoPath, &secretWo)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	w, err := webhookResourceToPortBody(ctx, state)
+	w, err := webhookResourceToPortBody(ctx, state, secretWo)
 	if err != nil {
 		resp.Diagnostics.AddError("failed to convert webhook resource to body", err.Error())
 		return
@@ -100,15 +104,18 @@ func writeWebhookComputedFieldsToState(state *WebhookModel, wp *cli.Webhook) {
 func (r *WebhookResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var state *WebhookModel
 	var previousState *WebhookModel
+	var secretWo types.String
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &state)...)
 	resp.Diagnostics.Append(req.State.Get(ctx, &previousState)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, secretWoPath, &secretWo)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	w, err := webhookResourceToPortBody(ctx, state)
+	// The webhook is replaced as a whole, so the write-only secret is sent on every update to keep it.
+	w, err := webhookResourceToPortBody(ctx, state, secretWo)
 	if err != nil {
 		resp.Diagnostics.AddError("failed to convert webhook resource to body", err.Error())
 		return
@@ -6,8 +6,8 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"github.com/port-labs/terraform-provider-port-labs/v2/internal/acctest"
-	"github.com/port-labs/terraform-provider-port-labs/v2/internal/utils"
 )
 
 func testAccCreateBlueprintConfig(identifier string) string {
@@ -127,8 +127,8 @@ func testAccCreateBlueprintConfigWithMultipleRelations(identifier string, author
 }
 
 func TestAccPortWebhookBasic(t *testing.T) {
-	identifier := utils.GenID()
-	webhookIdentifier := utils.GenID()
+	identifier := acctest.GenID(t)
+	webhookIdentifier := acctest.GenID(t)
 	var testAccActionConfigCreate = testAccCreateBlueprintConfig(identifier) + fmt.Sprintf(`
 	resource "port_webhook" "create_pr" {
 		identifier = "%s"
@@ -138,7 +138,7 @@ func TestAccPortWebhookBasic(t *testing.T) {
 	}`, webhookIdentifier)
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: acctest.ProviderConfig + testAccActionConfigCreate,
@@ -168,8 +168,8 @@ func TestAccPortWebhookBasic(t *testing.T) {
 }
 
 func TestAccPortWebhook(t *testing.T) {
-	identifier := utils.GenID()
-	webhookIdentifier := utils.GenID()
+	identifier := acctest.GenID(t)
+	webhookIdentifier := acctest.GenID(t)
 	var testAccActionConfigCreate = testAccCreateBlueprintConfig(identifier) + fmt.Sprintf(`
 	resource "port_webhook" "create_pr" {
 		identifier = "%s"
@@ -211,7 +211,7 @@ func TestAccPortWebhook(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: acctest.ProviderConfig + testAccActionConfigCreate,
@@ -254,8 +254,8 @@ func TestAccPortWebhook(t *testing.T) {
 }
 
 func TestAccPortWebhookWithAllOperationOptions(t *testing.T) {
-	identifier := utils.GenID()
-	webhookIdentifier := utils.GenID()
+	identifier := acctest.GenID(t)
+	webhookIdentifier := acctest.GenID(t)
 	var testAccActionConfigCreate = testAccCreateBlueprintConfig(identifier) + fmt.Sprintf(`
 	resource "port_webhook" "create_pr" {
 		identifier = "%s"
@@ -336,7 +336,7 @@ func TestAccPortWebhookWithAllOperationOptions(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: acctest.ProviderConfig + testAccActionConfigCreate,
@@ -399,8 +399,8 @@ func TestAccPortWebhookWithAllOperationOptions(t *testing.T) {
 }
 
 func TestAccPortWebhookImport(t *testing.T) {
-	identifier := utils.GenID()
-	webhookIdentifier := utils.GenID()
+	identifier := acctest.GenID(t)
+	webhookIdentifier := acctest.GenID(t)
 	var testAccActionConfigCreate = testAccCreateBlueprintConfig(identifier) + fmt.Sprintf(`
 	resource "port_webhook" "create_pr" {
 		identifier = "%s"
@@ -440,7 +440,7 @@ func TestAccPortWebhookImport(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: acctest.ProviderConfig + testAccActionConfigCreate,
@@ -488,9 +488,9 @@ func TestAccPortWebhookImport(t *testing.T) {
 }
 
 func TestAccPortWebhookUpdateIdentifier(t *testing.T) {
-	identifier := utils.GenID()
-	webhookIdentifier := utils.GenID()
-	webhookIdentifierUpdated := utils.GenID()
+	identifier := acctest.GenID(t)
+	webhookIdentifier := acctest.GenID(t)
+	webhookIdentifierUpdated := acctest.GenID(t)
 	var testAccActionConfigCreate = testAccCreateBlueprintConfig(identifier) + fmt.Sprintf(`
 	resource "port_webhook" "create_pr" {
 		identifier = "%s"
@@ -509,7 +509,7 @@ func TestAccPortWebhookUpdateIdentifier(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: acctest.ProviderConfig + testAccActionConfigCreate,
@@ -534,10 +534,10 @@ func TestAccPortWebhookUpdateIdentifier(t *testing.T) {
 }
 
 func TestAccPortWebhookCreateWithRelations(t *testing.T) {
-	identifier := utils.GenID()
-	authorIdentifier := utils.GenID()
-	teamIdentifier := utils.GenID()
-	webhookIdentifier := utils.GenID()
+	identifier := acctest.GenID(t)
+	authorIdentifier := acctest.GenID(t)
+	teamIdentifier := acctest.GenID(t)
+	webhookIdentifier := acctest.GenID(t)
 
 	// Test case 1: JSON relations with combinator/rules structure
 	var testPortWebhookConfigJSON = testAccCreateBlueprintConfigWithRelations(identifier, authorIdentifier) + fmt.Sprintf(`
@@ -651,7 +651,7 @@ func TestAccPortWebhookCreateWithRelations(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: acctest.ProviderConfig + testPortWebhookConfigJSON,
@@ -707,9 +707,9 @@ func TestAccPortWebhookCreateWithRelations(t *testing.T) {
 }
 
 func TestAccPortWebhookCreateWithInvalidRelations(t *testing.T) {
-	identifier := utils.GenID()
-	authorIdentifier := utils.GenID()
-	webhookIdentifier := utils.GenID()
+	identifier := acctest.GenID(t)
+	authorIdentifier := acctest.GenID(t)
+	webhookIdentifier := acctest.GenID(t)
 
 	// Test case 1: Missing combinator field
 	var testPortWebhookConfigMissingCombinator = testAccCreateBlueprintConfigWithRelations(identifier, authorIdentifier) + fmt.Sprintf(`
@@ -821,7 +821,7 @@ func TestAccPortWebhookCreateWithInvalidRelations(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config:      acctest.ProviderConfig + testPortWebhookConfigMissingCombinator,
@@ -840,9 +840,9 @@ func TestAccPortWebhookCreateWithInvalidRelations(t *testing.T) {
 }
 
 func TestAccPortWebhookUpdateRelationType(t *testing.T) {
-	identifier := utils.GenID()
-	authorIdentifier := utils.GenID()
-	webhookIdentifier := utils.GenID()
+	identifier := acctest.GenID(t)
+	authorIdentifier := acctest.GenID(t)
+	webhookIdentifier := acctest.GenID(t)
 
 	// Initial config with string relation
 	var testAccWebhookConfigStringRelation = testAccCreateBlueprintConfigWithRelations(identifier, authorIdentifier) + fmt.Sprintf(`
@@ -915,7 +915,7 @@ func TestAccPortWebhookUpdateRelationType(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: acctest.ProviderConfig + testAccWebhookConfigStringRelation,
@@ -954,8 +954,8 @@ func TestAccPortWebhookUpdateRelationType(t *testing.T) {
 }
 
 func TestAccPortWebhookCreateWithComplexIdentifier(t *testing.T) {
-	identifier := utils.GenID()
-	webhookIdentifier := utils.GenID()
+	identifier := acctest.GenID(t)
+	webhookIdentifier := acctest.GenID(t)
 
 	var testPortWebhookConfigComplexIdentifier = testAccCreateBlueprintConfig(identifier) + fmt.Sprintf(`
 	resource "port_webhook" "complex_identifier" {
@@ -994,7 +994,7 @@ func TestAccPortWebhookCreateWithComplexIdentifier(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: acctest.ProviderConfig + testPortWebhookConfigComplexIdentifier,
@@ -1036,8 +1036,8 @@ func TestAccPortWebhookCreateWithComplexIdentifier(t *testing.T) {
 }
 
 func TestAccPortWebhookCreateWithInvalidIdentifier(t *testing.T) {
-	identifier := utils.GenID()
-	webhookIdentifier := utils.GenID()
+	identifier := acctest.GenID(t)
+	webhookIdentifier := acctest.GenID(t)
 
 	// Test case 1: Missing combinator field in identifier
 	var testPortWebhookConfigMissingCombinator = testAccCreateBlueprintConfig(identifier) + fmt.Sprintf(`
@@ -1128,7 +1128,7 @@ func TestAccPortWebhookCreateWithInvalidIdentifier(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: acctest.ProviderConfig + testPortWebhookConfigMissingCombinator,
@@ -1155,3 +1155,61 @@ func TestAccPortWebhookCreateWithInvalidIdentifier(t *testing.T) {
 		},
 	})
 }
+
+func TestAccPortWebhookWriteOnlySecret(t *testing.T) {
+	identifier := acctest.GenID(t)
+	webhookIdentifier := acctest.GenID(t)
+	testAccWebhookConfig := func(secret string, version int) string {
+		return testAccCreateBlueprintConfig(identifier) + fmt.Sprintf(`
+	resource "port_webhook" "create_pr" {
+		identifier = "%s"
+		title      = "Test"
+		icon       = "Terraform"
+		enabled    = true
+		security = {
+			secret_wo               = "%s"
+			secret_wo_version       = %d
+			signature_header_name   = "X-Hub-Signature-256"
+			signature_algorithm     = "sha256"
+			signature_prefix        = "sha256="
+			request_identifier_path = ".body.repository.full_name"
+		}
+		mappings = [
+			{
+				"blueprint" = port_blueprint.microservice.identifier,
+				"entity" = {
+					"identifier" = ".body.pull_request.id | tostring",
+					"title" = ".body.pull_request.title",
+				}
+			}
+		]
+	}`, webhookIdentifier, secret, version)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig + testAccWebhookConfig("initial-secret", 1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("port_webhook.create_pr", "identifier", webhookIdentifier),
+					resource.TestCheckNoResourceAttr("port_webhook.create_pr", "security.secret"),
+					resource.TestCheckNoResourceAttr("port_webhook.create_pr", "security.secret_wo"),
+					resource.TestCheckResourceAttr("port_webhook.create_pr", "security.secret_wo_version", "1"),
+					resource.TestCheckResourceAttr("port_webhook.create_pr", "security.signature_header_name", "X-Hub-Signature-256"),
+				),
+			},
+			{
+				Config: acctest.ProviderConfig + testAccWebhookConfig("rotated-secret", 2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckNoResourceAttr("port_webhook.create_pr", "security.secret"),
+					resource.TestCheckResourceAttr("port_webhook.create_pr", "security.secret_wo_version", "2"),
+				),
+			},
+		},
+	})
+}
@@ -3,7 +3,10 @@ package webhook
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -12,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/jq"
 )
 
 func WebhookSecuritySchema() map[string]schema.Attribute {
@@ -19,6 +23,26 @@ func WebhookSecuritySchema() map[string]schema.Attribute {
 		"secret": schema.StringAttribute{
 			MarkdownDescription: "The secret of the webhook",
 			Optional:            true,
+			Sensitive:           true,
+			Validators: []validator.String{
+				stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("secret_wo")),
+			},
+		},
+		"secret_wo": schema.StringAttribute{
+			MarkdownDescription: "The secret of the webhook, which is never stored in the plan or state. Requires Terraform 1.11 or later. Since its changes can't be detected, increment `secret_wo_version` to update the secret",
+			Optional:            true,
+			Sensitive:           true,
+			WriteOnly:           true,
+			Validators: []validator.String{
+				stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("secret_wo_version")),
+			},
+		},
+		"secret_wo_version": schema.Int64Attribute{
+			MarkdownDescription: "The version of `secret_wo`. Changing it updates the webhook with the current value of `secret_wo`",
+			Optional:            true,
+			Validators: []validator.Int64{
+				int64validator.AlsoRequires(path.MatchRelative().AtParent().AtName("secret_wo")),
+			},
 		},
 		"signature_header_name": schema.StringAttribute{
 			MarkdownDescription: "The signature header name of the webhook",
@@ -48,10 +72,12 @@ func WebhookMappingSchema() map[string]schema.Attribute {
 		"filter": schema.StringAttribute{
 			MarkdownDescription: "The filter of the mapping",
 			Optional:            true,
+			Validators:          []validator.String{jq.ExpressionValidator()},
 		},
 		"items_to_parse": schema.StringAttribute{
 			MarkdownDescription: "The items to parser of the mapping",
 			Optional:            true,
+			Validators:          []validator.String{jq.ExpressionValidator()},
 		},
 		"operation": schema.SingleNestedAttribute{
 			MarkdownDescription: "The operation of the mapping",
@@ -77,28 +103,34 @@ func WebhookMappingSchema() map[string]schema.Attribute {
 				"identifier": schema.StringAttribute{
 					MarkdownDescription: "The identifier of the entity. Can be either a simple JQ expression (string) or a search query object encoded with jsonencode(). When using search query objects, the structure must include 'combinator' and 'rules' fields, and each rule must have 'property', 'operator', and 'value' fields.",
 					Required:            true,
+					Validators:          []validator.String{jq.MappingValidator()},
 				},
 				"title": schema.StringAttribute{
 					MarkdownDescription: "The title of the entity",
 					Optional:            true,
+					Validators:          []validator.String{jq.ExpressionValidator()},
 				},
 				"icon": schema.StringAttribute{
 					MarkdownDescription: "The icon of the entity",
 					Optional:            true,
+					Validators:          []validator.String{jq.ExpressionValidator()},
 				},
 				"team": schema.StringAttribute{
 					MarkdownDescription: "The team of the entity",
 					Optional:            true,
+					Validators:          []validator.String{jq.ExpressionValidator()},
 				},
 				"properties": schema.MapAttribute{
 					MarkdownDescription: "The properties of the entity",
 					Optional:            true,
 					ElementType:         types.StringType,
+					Validators:          []validator.Map{mapvalidator.ValueStringsAre(jq.ExpressionValidator())},
 				},
 				"relations": schema.MapAttribute{
 					MarkdownDescription: "The relations of the entity. Relations can be defined as either simple JQ expressions (strings) or search query objects. When using objects, the rules array must be encoded with jsonencode().",
 					Optional:            true,
 					ElementType:         types.StringType,
+					Validators:          []validator.Map{mapvalidator.ValueStringsAre(jq.MappingValidator())},
 				},
 			},
 		},
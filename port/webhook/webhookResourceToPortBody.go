@@ -5,10 +5,13 @@ import (
 	"encoding/json"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
 )
 
-func webhookResourceToPortBody(ctx context.Context, state *WebhookModel) (*cli.Webhook, error) {
+// webhookResourceToPortBody converts the planned webhook to a request body. secretWo is the write-only secret of the
+// webhook, which is only available in the configuration.
+func webhookResourceToPortBody(ctx context.Context, state *WebhookModel, secretWo types.String) (*cli.Webhook, error) {
 	w := &cli.Webhook{
 		Identifier: state.Identifier.ValueString(),
 		Security:   &cli.Security{},
@@ -38,6 +41,10 @@ func webhookResourceToPortBody(ctx context.Context, state *WebhookModel) (*cli.W
 			secret := state.Security.Secret.ValueString()
 			w.Security.Secret = &secret
 		}
+		if !secretWo.IsNull() {
+			secret := secretWo.ValueString()
+			w.Security.Secret = &secret
+		}
 		if !state.Security.SignatureHeaderName.IsNull() {
 			signatureHeaderName := state.Security.SignatureHeaderName.ValueString()
 			w.Security.SignatureHeaderName = &signatureHeaderName
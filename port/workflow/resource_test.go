@@ -6,7 +6,6 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/port-labs/terraform-provider-port-labs/v2/internal/acctest"
-	"github.com/port-labs/terraform-provider-port-labs/v2/internal/utils"
 )
 
 func testAccCreateBlueprintConfig(identifier string) string {
@@ -28,8 +27,8 @@ func testAccCreateBlueprintConfig(identifier string) string {
 }
 
 func TestAccPortWorkflowBasic(t *testing.T) {
-	blueprintIdentifier := utils.GenID()
-	workflowIdentifier := utils.GenID()
+	blueprintIdentifier := acctest.GenID(t)
+	workflowIdentifier := acctest.GenID(t)
 
 	var testAccWorkflowConfigCreate = testAccCreateBlueprintConfig(blueprintIdentifier) + fmt.Sprintf(`
 	resource "port_workflow" "review_pr" {
@@ -78,7 +77,7 @@ func TestAccPortWorkflowBasic(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: acctest.ProviderConfig + testAccWorkflowConfigCreate,
@@ -105,8 +104,8 @@ func TestAccPortWorkflowBasic(t *testing.T) {
 }
 
 func TestAccPortWorkflowUpdate(t *testing.T) {
-	blueprintIdentifier := utils.GenID()
-	workflowIdentifier := utils.GenID()
+	blueprintIdentifier := acctest.GenID(t)
+	workflowIdentifier := acctest.GenID(t)
 
 	var testAccWorkflowConfigCreate = testAccCreateBlueprintConfig(blueprintIdentifier) + fmt.Sprintf(`
 	resource "port_workflow" "review_pr" {
@@ -181,7 +180,7 @@ func TestAccPortWorkflowUpdate(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: acctest.ProviderConfig + testAccWorkflowConfigCreate,
@@ -211,8 +210,8 @@ func TestAccPortWorkflowUpdate(t *testing.T) {
 }
 
 func TestAccPortWorkflowSelfServeTrigger(t *testing.T) {
-	blueprintIdentifier := utils.GenID()
-	workflowIdentifier := utils.GenID()
+	blueprintIdentifier := acctest.GenID(t)
+	workflowIdentifier := acctest.GenID(t)
 
 	var testAccWorkflowConfigCreate = testAccCreateBlueprintConfig(blueprintIdentifier) + fmt.Sprintf(`
 	resource "port_workflow" "deploy" {
@@ -262,7 +261,7 @@ func TestAccPortWorkflowSelfServeTrigger(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: acctest.ProviderConfig + testAccWorkflowConfigCreate,
@@ -279,8 +278,8 @@ func TestAccPortWorkflowSelfServeTrigger(t *testing.T) {
 }
 
 func TestAccPortWorkflowConditionOutlets(t *testing.T) {
-	blueprintIdentifier := utils.GenID()
-	workflowIdentifier := utils.GenID()
+	blueprintIdentifier := acctest.GenID(t)
+	workflowIdentifier := acctest.GenID(t)
 
 	var testAccWorkflowConfigCreate = testAccCreateBlueprintConfig(blueprintIdentifier) + fmt.Sprintf(`
 	resource "port_workflow" "branching" {
@@ -344,7 +343,7 @@ func TestAccPortWorkflowConditionOutlets(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: acctest.ProviderConfig + testAccWorkflowConfigCreate,
@@ -360,8 +359,8 @@ func TestAccPortWorkflowConditionOutlets(t *testing.T) {
 }
 
 func TestAccPortWorkflowImport(t *testing.T) {
-	blueprintIdentifier := utils.GenID()
-	workflowIdentifier := utils.GenID()
+	blueprintIdentifier := acctest.GenID(t)
+	workflowIdentifier := acctest.GenID(t)
 
 	// event_trigger has no secrets and no server-applied defaults, so every
 	// attribute round-trips exactly under ImportStateVerify.
@@ -382,7 +381,7 @@ func TestAccPortWorkflowImport(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: acctest.ProviderConfig + testAccWorkflowConfigCreate,
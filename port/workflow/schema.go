@@ -18,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/port-labs/terraform-provider-port-labs/v2/internal/consts"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/jq"
 )
 
 // CURSOR_AGENT is intentionally absent: it is still a live member of the service
@@ -142,7 +143,7 @@ func permissionsBlock(description string) schema.Block {
 			"`{\"combinator\":\"and\",\"rules\":[{\"property\":{\"context\":\"user\",\"property\":\"department\"},\"operator\":\"=\",\"value\":\"engineering\"}]}`. " +
 			"`context` is one of `user`, `userTeams`, `form`, `workflowRun`.",
 		Optional:   true,
-		Validators: []validator.String{queryValidator("Invalid permissions policy")},
+		Validators: []validator.String{jq.QueryValidator("Invalid permissions policy")},
 	}
 
 	return schema.SingleNestedBlock{
@@ -157,7 +158,7 @@ func respondersBlock(description string) schema.Block {
 		MarkdownDescription: "A JSON encoded entity search query, run against the `_user` blueprint, " +
 			"resolving additional responders.",
 		Optional:   true,
-		Validators: []validator.String{queryValidator("Invalid responders query")},
+		Validators: []validator.String{jq.QueryValidator("Invalid responders query")},
 	}
 
 	return schema.SingleNestedBlock{
@@ -191,6 +192,7 @@ func userInputsAttributes() map[string]schema.Attribute {
 						Optional:            true,
 						Validators: []validator.String{
 							stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("visible")),
+							jq.ExpressionValidator(),
 						},
 					},
 				},
@@ -199,6 +201,7 @@ func userInputsAttributes() map[string]schema.Attribute {
 		"required_jq_query": schema.StringAttribute{
 			MarkdownDescription: "A jq query resolving which inputs are required.",
 			Optional:            true,
+			Validators:          []validator.String{jq.ExpressionValidator()},
 		},
 		"order_properties": schema.ListAttribute{
 			MarkdownDescription: "The order the inputs are rendered in. Cannot be combined with `steps`.",
@@ -231,6 +234,7 @@ func userInputsAttributes() map[string]schema.Attribute {
 						Optional:            true,
 						Validators: []validator.String{
 							stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("visible")),
+							jq.ExpressionValidator(),
 						},
 					},
 					"validations": validationsSchema("Validation rules evaluated when the step is submitted."),
@@ -259,6 +263,7 @@ func validationsSchema(description string) schema.ListNestedAttribute {
 				"constraint": schema.StringAttribute{
 					MarkdownDescription: "A jq expression that has to evaluate to `true` for the form to be valid.",
 					Required:            true,
+					Validators:          []validator.String{jq.ExpressionValidator()},
 				},
 				"message": schema.StringAttribute{
 					MarkdownDescription: "The error message shown when the constraint evaluates to `false` (max 100 characters).",
@@ -371,6 +376,7 @@ func eventTriggerBlock() schema.Block {
 						MarkdownDescription: "The JQ expressions evaluated against the event.",
 						Optional:            true,
 						ElementType:         types.StringType,
+						Validators:          []validator.List{listvalidator.ValueStringsAre(jq.ExpressionValidator())},
 					},
 					"combinator": schema.StringAttribute{
 						MarkdownDescription: "How the expressions are combined. One of `and`, `or`.",
@@ -658,6 +664,7 @@ func conditionBlock() schema.Block {
 						"expression": schema.StringAttribute{
 							MarkdownDescription: "The JQ expression that selects this outlet.",
 							Required:            true,
+							Validators:          []validator.String{jq.ExpressionValidator()},
 						},
 					},
 					Blocks: map[string]schema.Block{
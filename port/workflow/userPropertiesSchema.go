@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/jq"
 	"github.com/port-labs/terraform-provider-port-labs/v2/internal/utils"
 )
 
@@ -81,6 +82,7 @@ func propertyMetadataSchema(propertyType string) map[string]schema.Attribute {
 			Optional:            true,
 			Validators: []validator.String{
 				stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("visible")),
+				jq.ExpressionValidator(),
 			},
 		},
 		"read_only": schema.BoolAttribute{
@@ -93,6 +95,7 @@ func propertyMetadataSchema(propertyType string) map[string]schema.Attribute {
 			Optional:            true,
 			Validators: []validator.String{
 				stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("read_only")),
+				jq.ExpressionValidator(),
 			},
 		},
 		"disabled": schema.BoolAttribute{
@@ -106,6 +109,7 @@ func propertyMetadataSchema(propertyType string) map[string]schema.Attribute {
 			Optional:            true,
 			Validators: []validator.String{
 				stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("disabled")),
+				jq.ExpressionValidator(),
 			},
 		},
 	}
@@ -122,6 +126,7 @@ func stringPropertySchema() schema.Attribute {
 			Optional:            true,
 			Validators: []validator.String{
 				stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("default")),
+				jq.ExpressionValidator(),
 			},
 		},
 		"format": schema.StringAttribute{
@@ -161,6 +166,7 @@ func stringPropertySchema() schema.Attribute {
 			Validators: []validator.String{
 				stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("pattern")),
 				stringvalidator.LengthAtLeast(1),
+				jq.ExpressionValidator(),
 			},
 		},
 		"enum": schema.ListAttribute{
@@ -177,6 +183,7 @@ func stringPropertySchema() schema.Attribute {
 			Optional:            true,
 			Validators: []validator.String{
 				stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("enum")),
+				jq.ExpressionValidator(),
 			},
 		},
 		"enum_colors": schema.MapAttribute{
@@ -205,6 +212,7 @@ func numberPropertySchema() schema.Attribute {
 			Optional:            true,
 			Validators: []validator.String{
 				stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("default")),
+				jq.ExpressionValidator(),
 			},
 		},
 		"minimum": schema.Float64Attribute{
@@ -237,6 +245,7 @@ func numberPropertySchema() schema.Attribute {
 			Optional:            true,
 			Validators: []validator.String{
 				stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("enum")),
+				jq.ExpressionValidator(),
 			},
 		},
 	}
@@ -260,6 +269,7 @@ func booleanPropertySchema() schema.Attribute {
 			Optional:            true,
 			Validators: []validator.String{
 				stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("default")),
+				jq.ExpressionValidator(),
 			},
 		},
 	}
@@ -283,6 +293,7 @@ func objectPropertySchema() schema.Attribute {
 			Optional:            true,
 			Validators: []validator.String{
 				stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("default")),
+				jq.ExpressionValidator(),
 			},
 		},
 		"format": schema.StringAttribute{
@@ -312,6 +323,7 @@ func arrayPropertySchema() schema.Attribute {
 				stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("string_items").AtName("default")),
 				stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("number_items").AtName("default")),
 				stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("object_items").AtName("default")),
+				jq.ExpressionValidator(),
 			},
 		},
 		"min_items": schema.Int64Attribute{
@@ -326,6 +338,7 @@ func arrayPropertySchema() schema.Attribute {
 			Optional:            true,
 			Validators: []validator.String{
 				stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("min_items")),
+				jq.ExpressionValidator(),
 			},
 		},
 		"max_items": schema.Int64Attribute{
@@ -340,6 +353,7 @@ func arrayPropertySchema() schema.Attribute {
 			Optional:            true,
 			Validators: []validator.String{
 				stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("max_items")),
+				jq.ExpressionValidator(),
 			},
 		},
 		"unique_items": schema.BoolAttribute{
@@ -380,6 +394,7 @@ func arrayPropertySchema() schema.Attribute {
 					Optional:            true,
 					Validators: []validator.String{
 						stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("enum")),
+						jq.ExpressionValidator(),
 					},
 				},
 				"enum_colors": schema.MapAttribute{
@@ -416,6 +431,7 @@ func arrayPropertySchema() schema.Attribute {
 					Optional:            true,
 					Validators: []validator.String{
 						stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("enum")),
+						jq.ExpressionValidator(),
 					},
 				},
 				"enum_colors": schema.MapAttribute{
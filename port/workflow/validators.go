@@ -79,32 +79,6 @@ func outputSchemaValidator() validator.String {
 	}
 }
 
-// Both the RBAC policy and the responders entity query are `{combinator, rules}`
-// objects. The rules themselves are a large union that is left to the API, so
-// only the envelope is checked here.
-func queryValidator(summary string) validator.String {
-	return stringValidator{
-		summary:     summary,
-		description: "must be a JSON encoded object with a `combinator` of `and` or `or` and a `rules` array",
-		validate: func(value string) error {
-			object, err := decodeJSONObject(value)
-			if err != nil {
-				return err
-			}
-
-			combinator, ok := object["combinator"].(string)
-			if !ok || (combinator != "and" && combinator != "or") {
-				return fmt.Errorf("must set `combinator` to `and` or `or`")
-			}
-
-			if _, ok := object["rules"].([]any); !ok {
-				return fmt.Errorf("must set `rules` to an array")
-			}
-			return nil
-		},
-	}
-}
-
 var (
 	cronFieldPattern  = regexp.MustCompile(`^[0-9A-Za-z*?,/#\-]+$`)
 	cronDescriptors   = []string{"@yearly", "@annually", "@monthly", "@weekly", "@daily", "@midnight", "@hourly"}
@@ -1593,16 +1593,6 @@ func TestOutputSchemaValidator(t *testing.T) {
 	}
 }
 
-func TestQueryValidator(t *testing.T) {
-	assert.Empty(t, errorSummaries(runStringValidator(queryValidator("Invalid policy"),
-		`{"combinator":"and","rules":[{"property":{"context":"user","property":"department"},"operator":"=","value":"engineering"}]}`)))
-
-	invalid := []string{`{"combinator":"maybe","rules":[]}`, `{"rules":[]}`, `{"combinator":"and"}`, `"and"`, `{`}
-	for _, value := range invalid {
-		assert.NotEmpty(t, errorSummaries(runStringValidator(queryValidator("Invalid policy"), value)), value)
-	}
-}
-
 // The API requires the `outlets` and `buttons` keys on the node types that own
 // them and accepts an empty list, so an empty one must survive serialization
 // rather than being dropped by omitempty.
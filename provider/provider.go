@@ -2,14 +2,25 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"os"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	fwaction "github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
 	"github.com/port-labs/terraform-provider-port-labs/v2/internal/consts"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/ratelimit"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/utils"
+	access_token "github.com/port-labs/terraform-provider-port-labs/v2/port/access-token"
 	"github.com/port-labs/terraform-provider-port-labs/v2/port/action"
 	action_permissions "github.com/port-labs/terraform-provider-port-labs/v2/port/action-permissions"
 	aggregation_properties "github.com/port-labs/terraform-provider-port-labs/v2/port/aggregation-properties"
@@ -17,10 +28,14 @@ import (
 	blueprint_permissions "github.com/port-labs/terraform-provider-port-labs/v2/port/blueprint-permissions"
 	"github.com/port-labs/terraform-provider-port-labs/v2/port/entity"
 	"github.com/port-labs/terraform-provider-port-labs/v2/port/folder"
+	"github.com/port-labs/terraform-provider-port-labs/v2/port/functions"
 	"github.com/port-labs/terraform-provider-port-labs/v2/port/integration"
+	integration_resync "github.com/port-labs/terraform-provider-port-labs/v2/port/integration-resync"
+	"github.com/port-labs/terraform-provider-port-labs/v2/port/migration"
 	"github.com/port-labs/terraform-provider-port-labs/v2/port/organization"
 	"github.com/port-labs/terraform-provider-port-labs/v2/port/page"
 	page_permissions "github.com/port-labs/terraform-provider-port-labs/v2/port/page-permissions"
+	run_action "github.com/port-labs/terraform-provider-port-labs/v2/port/run-action"
 	"github.com/port-labs/terraform-provider-port-labs/v2/port/scorecard"
 	"github.com/port-labs/terraform-provider-port-labs/v2/port/search"
 	system_blueprint "github.com/port-labs/terraform-provider-port-labs/v2/port/system_blueprint"
@@ -31,15 +46,27 @@ import (
 )
 
 var (
-	_ provider.Provider = &PortLabsProvider{}
+	_ provider.Provider                       = &PortLabsProvider{}
+	_ provider.ProviderWithEphemeralResources = &PortLabsProvider{}
+	_ provider.ProviderWithListResources      = &PortLabsProvider{}
+	_ provider.ProviderWithActions            = &PortLabsProvider{}
+	_ provider.ProviderWithFunctions          = &PortLabsProvider{}
 )
 
-type PortLabsProvider struct{}
+type PortLabsProvider struct {
+	// clientOptions are added to the options of the Port client, like the cassette of an acceptance test.
+	clientOptions []cli.Option
+}
 
 func New() provider.Provider {
 	return &PortLabsProvider{}
 }
 
+// NewWithClientOptions returns a provider whose Port client is created with the given options too.
+func NewWithClientOptions(opts ...cli.Option) provider.Provider {
+	return &PortLabsProvider{clientOptions: opts}
+}
+
 func (p *PortLabsProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
 	resp.TypeName = consts.ProviderName
 }
@@ -75,6 +102,60 @@ func (p *PortLabsProvider) Schema(ctx context.Context, req provider.SchemaReques
 				MarkdownDescription: "Protects you from accidentally changing the property type of blueprints which " +
 					"will delete the property before recreating it with the new type. Defaults to `true`",
 			},
+			"retry_count": schema.Int64Attribute{
+				Optional: true,
+				MarkdownDescription: "The maximum amount of times a request is retried when it's rate limited, or when " +
+					"an idempotent request fails with a 502, 503 or 504 response. Defaults to `5`",
+				Validators: []validator.Int64{int64validator.AtLeast(0)},
+			},
+			"retry_min_wait_ms": schema.Int64Attribute{
+				Optional: true,
+				MarkdownDescription: "The minimum time in milliseconds to wait before retrying a request. Retries back " +
+					"off exponentially with jitter, unless Port returns a `Retry-After` or `x-ratelimit-reset` header. " +
+					"Defaults to `500`",
+				Validators: []validator.Int64{int64validator.AtLeast(0)},
+			},
+			"retry_max_wait_ms": schema.Int64Attribute{
+				Optional: true,
+				MarkdownDescription: "The maximum time in milliseconds to wait before retrying a request, including " +
+					"waits requested by Port. Defaults to `30000`",
+				Validators: []validator.Int64{int64validator.AtLeast(0)},
+			},
+			"rate_limit": schema.SingleNestedAttribute{
+				Optional: true,
+				MarkdownDescription: "Client side rate limiting of the requests sent to Port. Unset attributes fall " +
+					"back to the `PORT_RATE_LIMIT_DISABLED` and `PORT_DEBUG_RATE_LIMIT` environment variables and " +
+					"the built-in defaults",
+				Attributes: map[string]schema.Attribute{
+					"enabled": schema.BoolAttribute{
+						Optional: true,
+						MarkdownDescription: "Whether requests are throttled according to the rate limit headers " +
+							"returned by Port. Defaults to `true`",
+					},
+					"min_interval_ms": schema.Int64Attribute{
+						Optional:            true,
+						MarkdownDescription: "The minimum time in milliseconds between two requests. Defaults to `50`",
+						Validators:          []validator.Int64{int64validator.AtLeast(0)},
+					},
+					"max_concurrency": schema.Int64Attribute{
+						Optional: true,
+						MarkdownDescription: "The maximum amount of requests sent to Port at the same time. `0` means " +
+							"no limit. Defaults to `0`",
+						Validators: []validator.Int64{int64validator.AtLeast(0)},
+					},
+					"debug": schema.BoolAttribute{
+						Optional:            true,
+						MarkdownDescription: "Writes the rate limiting debug logs to stderr. Defaults to `false`",
+					},
+					"shared_state_file": schema.StringAttribute{
+						Optional: true,
+						MarkdownDescription: "Path of a local state file through which all the provider instances on " +
+							"the same host that set it share the rate limit budget reported by Port, e.g. parallel " +
+							"Terraform runs in the same CI job. Not supported on Windows. Can also be set with the " +
+							"`PORT_RATE_LIMIT_SHARED_STATE_FILE` environment variable",
+					},
+				},
+			},
 		},
 	}
 }
@@ -100,7 +181,31 @@ func (p *PortLabsProvider) Configure(ctx context.Context, req provider.Configure
 		baseUrl = consts.DefaultBaseUrl
 	}
 
-	c, err := cli.New(baseUrl, cli.WithHeader("User-Agent", version.ProviderVersion))
+	retryCount := cli.DefaultRetryCount
+	if !data.RetryCount.IsNull() {
+		retryCount = int(data.RetryCount.ValueInt64())
+	}
+	retryMinWait := cli.DefaultRetryMinWait
+	if !data.RetryMinWaitMs.IsNull() {
+		retryMinWait = time.Duration(data.RetryMinWaitMs.ValueInt64()) * time.Millisecond
+	}
+	retryMaxWait := cli.DefaultRetryMaxWait
+	if !data.RetryMaxWaitMs.IsNull() {
+		retryMaxWait = time.Duration(data.RetryMaxWaitMs.ValueInt64()) * time.Millisecond
+	}
+	if retryMinWait > retryMaxWait {
+		resp.Diagnostics.AddError("Invalid retry wait times",
+			fmt.Sprintf("retry_min_wait_ms (%d) can't be greater than retry_max_wait_ms (%d)",
+				retryMinWait.Milliseconds(), retryMaxWait.Milliseconds()))
+		return
+	}
+
+	c, err := cli.New(baseUrl, append([]cli.Option{
+		cli.WithHeader("User-Agent", version.ProviderVersion),
+		cli.WithRetryCount(retryCount),
+		cli.WithRetryWaitTime(retryMinWait, retryMaxWait),
+		cli.WithRateLimitOptions(rateLimitOptions(data.RateLimit)),
+	}, p.clientOptions...)...)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to create Port-labs client", err.Error())
 		return
@@ -153,6 +258,37 @@ func (p *PortLabsProvider) Configure(ctx context.Context, req provider.Configure
 
 	resp.ResourceData = c
 	resp.DataSourceData = c
+	resp.EphemeralResourceData = c
+	resp.ListResourceData = c
+	resp.ActionData = c
+}
+
+// rateLimitOptions overrides the rate limiting options set through the environment with the ones set in the
+// provider's rate_limit attribute.
+func rateLimitOptions(rateLimit *cli.RateLimitModel) *ratelimit.Options {
+	opts := cli.RateLimitOptionsFromEnv()
+	if rateLimit == nil {
+		return opts
+	}
+	if !rateLimit.Enabled.IsNull() {
+		opts.Enabled = rateLimit.Enabled.ValueBoolPointer()
+	}
+	if !rateLimit.MinIntervalMs.IsNull() {
+		opts.MinRequestInterval = utils.PtrTo(time.Duration(rateLimit.MinIntervalMs.ValueInt64()) * time.Millisecond)
+	}
+	if !rateLimit.MaxConcurrency.IsNull() {
+		opts.MaxConcurrency = utils.PtrTo(int(rateLimit.MaxConcurrency.ValueInt64()))
+	}
+	if !rateLimit.SharedStateFile.IsNull() {
+		opts.SharedStateFile = rateLimit.SharedStateFile.ValueString()
+	}
+	if !rateLimit.Debug.IsNull() {
+		opts.Logger = nil
+		if rateLimit.Debug.ValueBool() {
+			opts.Logger = cli.RateLimitDebugLogger()
+		}
+	}
+	return opts
 }
 
 func (p *PortLabsProvider) Resources(ctx context.Context) []func() resource.Resource {
@@ -161,6 +297,7 @@ func (p *PortLabsProvider) Resources(ctx context.Context) []func() resource.Reso
 		blueprint_permissions.NewBlueprintPermissionsResource,
 		aggregation_properties.NewAggregationPropertiesResource,
 		entity.NewEntityResource,
+		entity.NewEntitiesResource,
 		integration.NewIntegrationResource,
 		action.NewActionResource,
 		action_permissions.NewActionPermissionsResource,
@@ -180,5 +317,40 @@ func (p *PortLabsProvider) Resources(ctx context.Context) []func() resource.Reso
 func (p *PortLabsProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		search.NewSearchDataSource,
+		blueprint.NewBlueprintDataSource,
+		blueprint.NewBlueprintsDataSource,
+		entity.NewEntityDataSource,
+		migration.NewMigrationDataSource,
+	}
+}
+
+func (p *PortLabsProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		access_token.NewAccessTokenEphemeralResource,
+	}
+}
+
+func (p *PortLabsProvider) ListResources(ctx context.Context) []func() list.ListResource {
+	return []func() list.ListResource{
+		blueprint.NewBlueprintListResource,
+		entity.NewEntityListResource,
+		action.NewActionListResource,
+		scorecard.NewScorecardListResource,
+	}
+}
+
+func (p *PortLabsProvider) Actions(ctx context.Context) []func() fwaction.Action {
+	return []func() fwaction.Action{
+		run_action.NewRunAction,
+		integration_resync.NewIntegrationResyncAction,
+	}
+}
+
+func (p *PortLabsProvider) Functions(ctx context.Context) []func() function.Function {
+	return []func() function.Function{
+		functions.NewJqFunction,
+		functions.NewIdentifierFunction,
+		functions.NewSearchRuleFunction,
+		functions.NewWebhookMappingPreviewFunction,
 	}
 }